@@ -9,6 +9,9 @@ func Default() UserFeatures {
 		CognitiveAccount: CognitiveAccountFeatures{
 			PurgeSoftDeleteOnDestroy: true,
 		},
+		ContainerGroup: ContainerGroupFeatures{
+			AdoptExisting: false,
+		},
 		KeyVault: KeyVaultFeatures{
 			PurgeSoftDeleteOnDestroy:         true,
 			PurgeSoftDeletedKeysOnDestroy:    true,
@@ -3,6 +3,7 @@ package features
 type UserFeatures struct {
 	ApiManagement          ApiManagementFeatures
 	CognitiveAccount       CognitiveAccountFeatures
+	ContainerGroup         ContainerGroupFeatures
 	VirtualMachine         VirtualMachineFeatures
 	VirtualMachineScaleSet VirtualMachineScaleSetFeatures
 	KeyVault               KeyVaultFeatures
@@ -16,6 +17,10 @@ type CognitiveAccountFeatures struct {
 	PurgeSoftDeleteOnDestroy bool
 }
 
+type ContainerGroupFeatures struct {
+	AdoptExisting bool
+}
+
 type VirtualMachineFeatures struct {
 	DeleteOSDiskOnDeletion     bool
 	GracefulShutdown           bool
@@ -40,6 +40,20 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 			},
 		},
 
+		"container_group": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"adopt_existing": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+				},
+			},
+		},
+
 		"key_vault": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
@@ -264,6 +278,16 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["container_group"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			containerGroupRaw := items[0].(map[string]interface{})
+			if v, ok := containerGroupRaw["adopt_existing"]; ok {
+				featuresMap.ContainerGroup.AdoptExisting = v.(bool)
+			}
+		}
+	}
+
 	if raw, ok := val["key_vault"]; ok {
 		items := raw.([]interface{})
 		if len(items) > 0 && items[0] != nil {
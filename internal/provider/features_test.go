@@ -24,6 +24,9 @@ func TestExpandFeatures(t *testing.T) {
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: true,
 				},
+				ContainerGroup: features.ContainerGroupFeatures{
+					AdoptExisting: false,
+				},
 				KeyVault: features.KeyVaultFeatures{
 					PurgeSoftDeletedCertsOnDestroy:   true,
 					PurgeSoftDeletedKeysOnDestroy:    true,
@@ -72,6 +75,11 @@ func TestExpandFeatures(t *testing.T) {
 							"purge_soft_delete_on_destroy": true,
 						},
 					},
+					"container_group": []interface{}{
+						map[string]interface{}{
+							"adopt_existing": true,
+						},
+					},
 					"key_vault": []interface{}{
 						map[string]interface{}{
 							"purge_soft_deleted_certificates_on_destroy": true,
@@ -127,6 +135,9 @@ func TestExpandFeatures(t *testing.T) {
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: true,
 				},
+				ContainerGroup: features.ContainerGroupFeatures{
+					AdoptExisting: true,
+				},
 				KeyVault: features.KeyVaultFeatures{
 					PurgeSoftDeletedCertsOnDestroy:   true,
 					PurgeSoftDeletedKeysOnDestroy:    true,
@@ -175,6 +186,11 @@ func TestExpandFeatures(t *testing.T) {
 							"purge_soft_delete_on_destroy": false,
 						},
 					},
+					"container_group": []interface{}{
+						map[string]interface{}{
+							"adopt_existing": false,
+						},
+					},
 					"key_vault": []interface{}{
 						map[string]interface{}{
 							"purge_soft_deleted_certificates_on_destroy": false,
@@ -230,6 +246,9 @@ func TestExpandFeatures(t *testing.T) {
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: false,
 				},
+				ContainerGroup: features.ContainerGroupFeatures{
+					AdoptExisting: false,
+				},
 				KeyVault: features.KeyVaultFeatures{
 					PurgeSoftDeletedCertsOnDestroy:   false,
 					PurgeSoftDeletedKeysOnDestroy:    false,
@@ -405,6 +424,71 @@ func TestExpandFeaturesCognitiveServices(t *testing.T) {
 	}
 }
 
+func TestExpandFeaturesContainerGroup(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"container_group": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				ContainerGroup: features.ContainerGroupFeatures{
+					AdoptExisting: false,
+				},
+			},
+		},
+		{
+			Name: "Adopt Existing Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"container_group": []interface{}{
+						map[string]interface{}{
+							"adopt_existing": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ContainerGroup: features.ContainerGroupFeatures{
+					AdoptExisting: true,
+				},
+			},
+		},
+		{
+			Name: "Adopt Existing Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"container_group": []interface{}{
+						map[string]interface{}{
+							"adopt_existing": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ContainerGroup: features.ContainerGroupFeatures{
+					AdoptExisting: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.ContainerGroup, testCase.Expected.ContainerGroup) {
+			t.Fatalf("Expected %+v but got %+v", result.ContainerGroup, testCase.Expected.ContainerGroup)
+		}
+	}
+}
+
 func TestExpandFeaturesKeyVault(t *testing.T) {
 	testData := []struct {
 		Name     string
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/authentication"
@@ -103,24 +105,28 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 			"subscription_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("ARM_SUBSCRIPTION_ID", ""),
 				Description: "The Subscription ID which should be used.",
 			},
 
 			"client_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_ID", ""),
 				Description: "The Client ID which should be used.",
 			},
 
 			"tenant_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("ARM_TENANT_ID", ""),
 				Description: "The Tenant ID which should be used.",
 			},
 
+			"environment_variable_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`), "`environment_variable_prefix` must start with a letter or underscore and can only contain letters, numbers and underscores"),
+				Description:  "A prefix which should be used in place of `ARM` when sourcing the authentication fields from Environment Variables, for example `PROD_ARM` would source the Client ID from `PROD_ARM_CLIENT_ID`.",
+			},
+
 			"auxiliary_tenant_ids": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -132,15 +138,13 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 
 			"environment": {
 				Type:        schema.TypeString,
-				Required:    true,
-				DefaultFunc: schema.EnvDefaultFunc("ARM_ENVIRONMENT", "public"),
+				Optional:    true,
 				Description: "The Cloud Environment which should be used. Possible values are public, usgovernment, german, and china. Defaults to public.",
 			},
 
 			"metadata_host": {
 				Type:        schema.TypeString,
-				Required:    true,
-				DefaultFunc: schema.EnvDefaultFunc("ARM_METADATA_HOSTNAME", ""),
+				Optional:    true,
 				Description: "The Hostname which should be used for the Azure Metadata Service.",
 			},
 
@@ -156,14 +160,12 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 			"client_certificate_path": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_CERTIFICATE_PATH", ""),
 				Description: "The path to the Client Certificate associated with the Service Principal for use when authenticating as a Service Principal using a Client Certificate.",
 			},
 
 			"client_certificate_password": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_CERTIFICATE_PASSWORD", ""),
 				Description: "The password associated with the Client Certificate. For use when authenticating as a Service Principal using a Client Certificate",
 			},
 
@@ -171,7 +173,6 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 			"client_secret": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_SECRET", ""),
 				Description: "The Client Secret which should be used. For use When authenticating as a Service Principal using a Client Secret.",
 			},
 
@@ -179,13 +180,11 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 			"use_msi": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("ARM_USE_MSI", false),
 				Description: "Allowed Managed Service Identity be used for Authentication.",
 			},
 			"msi_endpoint": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("ARM_MSI_ENDPOINT", ""),
 				Description: "The path to a custom endpoint for Managed Service Identity - in most circumstances this should be detected automatically. ",
 			},
 
@@ -250,9 +249,13 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 
 func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		envPrefix := d.Get("environment_variable_prefix").(string)
+
 		var auxTenants []string
 		if v, ok := d.Get("auxiliary_tenant_ids").([]interface{}); ok && len(v) > 0 {
 			auxTenants = *utils.ExpandStringSlice(v)
+		} else if v := os.Getenv(fmt.Sprintf("%s_AUXILIARY_TENANT_IDS", envPrefix)); envPrefix != "" && v != "" {
+			auxTenants = strings.Split(v, ";")
 		} else if v := os.Getenv("ARM_AUXILIARY_TENANT_IDS"); v != "" {
 			auxTenants = strings.Split(v, ";")
 		}
@@ -261,7 +264,7 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			return nil, diag.FromErr(fmt.Errorf("The provider only supports 3 auxiliary tenant IDs"))
 		}
 
-		metadataHost := d.Get("metadata_host").(string)
+		metadataHost := providerStringWithEnvPrefix(d, envPrefix, "metadata_host", "METADATA_HOSTNAME", "")
 		// TODO: remove in 3.0
 		// note: this is inline to avoid calling out deprecations for users not setting this
 		if v := d.Get("metadata_url").(string); v != "" {
@@ -270,22 +273,27 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			metadataHost = v
 		}
 
+		useMsi, err := providerBoolWithEnvPrefix(d, envPrefix, "use_msi", "USE_MSI", false)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
 		builder := &authentication.Builder{
-			SubscriptionID:     d.Get("subscription_id").(string),
-			ClientID:           d.Get("client_id").(string),
-			ClientSecret:       d.Get("client_secret").(string),
-			TenantID:           d.Get("tenant_id").(string),
+			SubscriptionID:     providerCredentialWithEnvPrefix(d, envPrefix, "subscription_id", "SUBSCRIPTION_ID"),
+			ClientID:           providerCredentialWithEnvPrefix(d, envPrefix, "client_id", "CLIENT_ID"),
+			ClientSecret:       providerCredentialWithEnvPrefix(d, envPrefix, "client_secret", "CLIENT_SECRET"),
+			TenantID:           providerCredentialWithEnvPrefix(d, envPrefix, "tenant_id", "TENANT_ID"),
 			AuxiliaryTenantIDs: auxTenants,
-			Environment:        d.Get("environment").(string),
+			Environment:        providerStringWithEnvPrefix(d, envPrefix, "environment", "ENVIRONMENT", "public"),
 			MetadataHost:       metadataHost,
-			MsiEndpoint:        d.Get("msi_endpoint").(string),
-			ClientCertPassword: d.Get("client_certificate_password").(string),
-			ClientCertPath:     d.Get("client_certificate_path").(string),
+			MsiEndpoint:        providerStringWithEnvPrefix(d, envPrefix, "msi_endpoint", "MSI_ENDPOINT", ""),
+			ClientCertPassword: providerCredentialWithEnvPrefix(d, envPrefix, "client_certificate_password", "CLIENT_CERTIFICATE_PASSWORD"),
+			ClientCertPath:     providerCredentialWithEnvPrefix(d, envPrefix, "client_certificate_path", "CLIENT_CERTIFICATE_PATH"),
 
 			// Feature Toggles
 			SupportsClientCertAuth:         true,
 			SupportsClientSecretAuth:       true,
-			SupportsManagedServiceIdentity: d.Get("use_msi").(bool),
+			SupportsManagedServiceIdentity: useMsi,
 			SupportsAzureCliToken:          true,
 			SupportsAuxiliaryTenants:       len(auxTenants) > 0,
 
@@ -377,3 +385,85 @@ More information on the "skip_provider_registration" flag can be found here:
 https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs#skip_provider_registration
 
 Original Error: %s`
+
+// providerStringWithEnvPrefix returns the value of the provider field `key` when it's set in the Provider block - otherwise
+// the Environment Variable `{prefix}_{suffix}` is used (when an `environment_variable_prefix` is specified), followed by
+// the Environment Variable `ARM_{suffix}` and finally `defaultValue`
+func providerStringWithEnvPrefix(d *schema.ResourceData, prefix, key, suffix, defaultValue string) string {
+	if providerFieldIsConfigured(d, key) {
+		return d.Get(key).(string)
+	}
+
+	for _, envVar := range providerEnvironmentVariableNames(prefix, suffix) {
+		if v := os.Getenv(envVar); v != "" {
+			return v
+		}
+	}
+
+	return defaultValue
+}
+
+// providerCredentialWithEnvPrefix returns the value of the credential field `key` when it's set in the Provider block -
+// otherwise when an `environment_variable_prefix` is specified only the Environment Variable `{prefix}_{suffix}` is used,
+// rather than falling back to `ARM_{suffix}`, so that credentials from different sets of Environment Variables (e.g. a
+// prefixed Client ID and an unprefixed Client Secret) are never combined
+func providerCredentialWithEnvPrefix(d *schema.ResourceData, prefix, key, suffix string) string {
+	if providerFieldIsConfigured(d, key) {
+		return d.Get(key).(string)
+	}
+
+	if prefix != "" {
+		return os.Getenv(fmt.Sprintf("%s_%s", prefix, suffix))
+	}
+
+	return os.Getenv(fmt.Sprintf("ARM_%s", suffix))
+}
+
+// providerBoolWithEnvPrefix is the boolean equivalent of providerStringWithEnvPrefix
+func providerBoolWithEnvPrefix(d *schema.ResourceData, prefix, key, suffix string, defaultValue bool) (bool, error) {
+	if providerFieldIsConfigured(d, key) {
+		return d.Get(key).(bool), nil
+	}
+
+	for _, envVar := range providerEnvironmentVariableNames(prefix, suffix) {
+		v := os.Getenv(envVar)
+		if v == "" {
+			continue
+		}
+
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			return false, fmt.Errorf("parsing the Environment Variable %q as a boolean: %+v", envVar, err)
+		}
+
+		return parsed, nil
+	}
+
+	return defaultValue, nil
+}
+
+// providerEnvironmentVariableNames returns the Environment Variables which can be used for a provider field,
+// in order of precedence
+func providerEnvironmentVariableNames(prefix, suffix string) []string {
+	names := make([]string, 0, 2)
+	if prefix != "" {
+		names = append(names, fmt.Sprintf("%s_%s", prefix, suffix))
+	}
+	return append(names, fmt.Sprintf("ARM_%s", suffix))
+}
+
+// providerFieldIsConfigured returns whether the provider field `key` has been set in the Provider block.
+//
+// The raw configuration is used when it's available - however the Plugin SDK doesn't populate this when configuring
+// the Provider, in which case we fall back to GetOkExists. This is reliable since these fields intentionally don't
+// define a Default/DefaultFunc (the Environment Variables are instead handled above), meaning that a value can only
+// be present when it's been specified in the Provider block.
+func providerFieldIsConfigured(d *schema.ResourceData, key string) bool {
+	if raw := d.GetRawConfig(); raw.IsKnown() && !raw.IsNull() {
+		return !raw.GetAttr(key).IsNull()
+	}
+
+	// nolint staticcheck
+	_, ok := d.GetOkExists(key)
+	return ok
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"testing"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestProvider(t *testing.T) {
@@ -89,3 +91,226 @@ func TestResourcesSupportCustomTimeouts(t *testing.T) {
 func TestProvider_impl(t *testing.T) {
 	_ = AzureProvider()
 }
+
+func TestProviderStringWithEnvPrefix(t *testing.T) {
+	t.Setenv("ARM_CLIENT_ID", "arm-client-id")
+	t.Setenv("ARM_TENANT_ID", "")
+	t.Setenv("PROD_ARM_CLIENT_ID", "prod-client-id")
+	t.Setenv("PROD_ARM_TENANT_ID", "prod-tenant-id")
+	t.Setenv("ARM_ENVIRONMENT", "")
+	t.Setenv("DEV_ARM_ENVIRONMENT", "")
+
+	testData := []struct {
+		Name     string
+		Config   map[string]interface{}
+		Prefix   string
+		Key      string
+		Suffix   string
+		Default  string
+		Expected string
+	}{
+		{
+			Name:     "No Prefix",
+			Config:   map[string]interface{}{},
+			Key:      "client_id",
+			Suffix:   "CLIENT_ID",
+			Expected: "arm-client-id",
+		},
+		{
+			Name:     "Prefixed Environment Variable",
+			Config:   map[string]interface{}{},
+			Prefix:   "PROD_ARM",
+			Key:      "client_id",
+			Suffix:   "CLIENT_ID",
+			Expected: "prod-client-id",
+		},
+		{
+			Name:     "Prefixed Environment Variable without an ARM Environment Variable",
+			Config:   map[string]interface{}{},
+			Prefix:   "PROD_ARM",
+			Key:      "tenant_id",
+			Suffix:   "TENANT_ID",
+			Expected: "prod-tenant-id",
+		},
+		{
+			Name:     "Prefixed Environment Variable not set",
+			Config:   map[string]interface{}{},
+			Prefix:   "DEV_ARM",
+			Key:      "client_id",
+			Suffix:   "CLIENT_ID",
+			Expected: "arm-client-id",
+		},
+		{
+			Name: "Provider Block takes precedence",
+			Config: map[string]interface{}{
+				"client_id": "configured-client-id",
+			},
+			Prefix:   "PROD_ARM",
+			Key:      "client_id",
+			Suffix:   "CLIENT_ID",
+			Expected: "configured-client-id",
+		},
+		{
+			Name: "Provider Block matching the ARM Environment Variable takes precedence",
+			Config: map[string]interface{}{
+				"client_id": "arm-client-id",
+			},
+			Prefix:   "PROD_ARM",
+			Key:      "client_id",
+			Suffix:   "CLIENT_ID",
+			Expected: "arm-client-id",
+		},
+		{
+			Name: "Provider Block matching the Default takes precedence",
+			Config: map[string]interface{}{
+				"environment": "public",
+			},
+			Prefix:   "PROD_ARM",
+			Key:      "environment",
+			Suffix:   "ENVIRONMENT",
+			Default:  "public",
+			Expected: "public",
+		},
+		{
+			Name:     "Default",
+			Config:   map[string]interface{}{},
+			Prefix:   "DEV_ARM",
+			Key:      "environment",
+			Suffix:   "ENVIRONMENT",
+			Default:  "public",
+			Expected: "public",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		p := TestAzureProvider()
+		d := schema.TestResourceDataRaw(t, p.Schema, v.Config)
+
+		actual := providerStringWithEnvPrefix(d, v.Prefix, v.Key, v.Suffix, v.Default)
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestProviderCredentialWithEnvPrefix(t *testing.T) {
+	// the prefixed credentials are only half-populated, so the remaining credentials mustn't be sourced from `ARM_*`
+	t.Setenv("ARM_CLIENT_ID", "arm-client-id")
+	t.Setenv("ARM_CLIENT_SECRET", "arm-client-secret")
+	t.Setenv("ARM_TENANT_ID", "arm-tenant-id")
+	t.Setenv("PROD_ARM_CLIENT_ID", "prod-client-id")
+	t.Setenv("PROD_ARM_CLIENT_SECRET", "")
+	t.Setenv("PROD_ARM_TENANT_ID", "")
+
+	testData := []struct {
+		Name     string
+		Config   map[string]interface{}
+		Prefix   string
+		Key      string
+		Suffix   string
+		Expected string
+	}{
+		{
+			Name:     "No Prefix",
+			Config:   map[string]interface{}{},
+			Key:      "client_secret",
+			Suffix:   "CLIENT_SECRET",
+			Expected: "arm-client-secret",
+		},
+		{
+			Name:     "Prefixed Environment Variable",
+			Config:   map[string]interface{}{},
+			Prefix:   "PROD_ARM",
+			Key:      "client_id",
+			Suffix:   "CLIENT_ID",
+			Expected: "prod-client-id",
+		},
+		{
+			Name:     "Prefixed Environment Variable not set doesn't fall back to the ARM Environment Variable",
+			Config:   map[string]interface{}{},
+			Prefix:   "PROD_ARM",
+			Key:      "client_secret",
+			Suffix:   "CLIENT_SECRET",
+			Expected: "",
+		},
+		{
+			Name:     "Prefixed Tenant ID not set doesn't fall back to the ARM Environment Variable",
+			Config:   map[string]interface{}{},
+			Prefix:   "PROD_ARM",
+			Key:      "tenant_id",
+			Suffix:   "TENANT_ID",
+			Expected: "",
+		},
+		{
+			Name: "Provider Block takes precedence",
+			Config: map[string]interface{}{
+				"client_secret": "configured-client-secret",
+			},
+			Prefix:   "PROD_ARM",
+			Key:      "client_secret",
+			Suffix:   "CLIENT_SECRET",
+			Expected: "configured-client-secret",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		p := TestAzureProvider()
+		d := schema.TestResourceDataRaw(t, p.Schema, v.Config)
+
+		actual := providerCredentialWithEnvPrefix(d, v.Prefix, v.Key, v.Suffix)
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestProviderBoolWithEnvPrefix(t *testing.T) {
+	t.Setenv("ARM_USE_MSI", "")
+	t.Setenv("PROD_ARM_USE_MSI", "true")
+
+	testData := []struct {
+		Name     string
+		Config   map[string]interface{}
+		Prefix   string
+		Expected bool
+	}{
+		{
+			Name:     "No Prefix",
+			Config:   map[string]interface{}{},
+			Expected: false,
+		},
+		{
+			Name:     "Prefixed Environment Variable",
+			Config:   map[string]interface{}{},
+			Prefix:   "PROD_ARM",
+			Expected: true,
+		},
+		{
+			Name: "Provider Block matching the Default takes precedence",
+			Config: map[string]interface{}{
+				"use_msi": false,
+			},
+			Prefix:   "PROD_ARM",
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		p := TestAzureProvider()
+		d := schema.TestResourceDataRaw(t, p.Schema, v.Config)
+
+		actual, err := providerBoolWithEnvPrefix(d, v.Prefix, "use_msi", "USE_MSI", false)
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
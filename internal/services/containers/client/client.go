@@ -16,7 +16,9 @@ type Client struct {
 	MaintenanceConfigurationsClient *containerservice.MaintenanceConfigurationsClient
 	RegistriesClient                *containerregistry.RegistriesClient
 	ReplicationsClient              *containerregistry.ReplicationsClient
+	RunsClient                      *containerregistry.RunsClient
 	ServicesClient                  *legacy.ContainerServicesClient
+	SnapshotsClient                 *containerservice.SnapshotsClient
 	WebhooksClient                  *containerregistry.WebhooksClient
 	TokensClient                    *containerregistry.TokensClient
 	ScopeMapsClient                 *containerregistry.ScopeMapsClient
@@ -34,6 +36,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	replicationsClient := containerregistry.NewReplicationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&replicationsClient.Client, o.ResourceManagerAuthorizer)
 
+	runsClient := containerregistry.NewRunsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&runsClient.Client, o.ResourceManagerAuthorizer)
+
 	tokensClient := containerregistry.NewTokensClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&tokensClient.Client, o.ResourceManagerAuthorizer)
 
@@ -53,6 +58,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	maintenanceConfigurationsClient := containerservice.NewMaintenanceConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&maintenanceConfigurationsClient.Client, o.ResourceManagerAuthorizer)
 
+	snapshotsClient := containerservice.NewSnapshotsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&snapshotsClient.Client, o.ResourceManagerAuthorizer)
+
 	servicesClient := legacy.NewContainerServicesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&servicesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -64,7 +72,9 @@ func NewClient(o *common.ClientOptions) *Client {
 		RegistriesClient:                &registriesClient,
 		WebhooksClient:                  &webhooksClient,
 		ReplicationsClient:              &replicationsClient,
+		RunsClient:                      &runsClient,
 		ServicesClient:                  &servicesClient,
+		SnapshotsClient:                 &snapshotsClient,
 		Environment:                     o.Environment,
 		TokensClient:                    &tokensClient,
 		ScopeMapsClient:                 &scopeMapsClient,
@@ -0,0 +1,36 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func importContainerGroup(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	id, err := parse.ContainerGroupID(d.Id())
+	if err != nil {
+		return []*pluginsdk.ResourceData{}, err
+	}
+
+	client := meta.(*clients.Client).Containers.GroupsClient
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return []*pluginsdk.ResourceData{}, fmt.Errorf("retrieving Container Group %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	// the Network Profile isn't read back during a refresh since it's only available from the API as the ID of the
+	// Network Profile at the time of creation - as such we populate it at import time, so that the configuration
+	// generated for an imported Container Group deployed into a Virtual Network matches what's in Azure
+	networkProfileId := ""
+	if props := resp.ContainerGroupProperties; props != nil {
+		if profile := props.NetworkProfile; profile != nil && profile.ID != nil {
+			networkProfileId = *profile.ID
+		}
+	}
+	d.Set("network_profile_id", networkProfileId)
+
+	return []*pluginsdk.ResourceData{d}, nil
+}
@@ -5,6 +5,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"reflect"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -25,16 +28,20 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+// containerGroupAzureClientIdEnvironmentVariable is the Environment Variable used by the Azure SDKs (e.g. within
+// DefaultAzureCredential) to determine which User Assigned Identity to authenticate using
+const containerGroupAzureClientIdEnvironmentVariable = "AZURE_CLIENT_ID"
+
 func resourceContainerGroup() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceContainerGroupCreate,
 		Read:   resourceContainerGroupRead,
 		Delete: resourceContainerGroupDelete,
 		Update: resourceContainerGroupUpdate,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
 			_, err := parse.ContainerGroupID(id)
 			return err
-		}),
+		}, importContainerGroup),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -43,6 +50,8 @@ func resourceContainerGroup() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceContainerGroupCustomizeDiff),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -158,6 +167,14 @@ func resourceContainerGroup() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 
+			"inject_msi_endpoint_env": {
+				Type:         pluginsdk.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      false,
+				RequiredWith: []string{"identity"},
+			},
+
 			"restart_policy": {
 				Type:             pluginsdk.TypeString,
 				Optional:         true,
@@ -498,6 +515,107 @@ func resourceContainerGroup() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"subscription_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"last_restart_at": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			// the values of secure environment variables aren't returned by the API and `secure_environment_variables`
+			// is Sensitive, so the names are exposed separately - this is a top-level attribute since the CustomizeDiff
+			// can only set the planned value of top-level Computed attributes
+			"secure_environment_variable_names": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"container_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"names": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"summary": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"ip_address": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"fqdn": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"ports": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"port": {
+										Type:     pluginsdk.TypeInt,
+										Computed: true,
+									},
+
+									"protocol": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"principal_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"identity_ids": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"containers": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"image": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"dns_config": {
 				Optional: true,
 				MaxItems: 1,
@@ -538,6 +656,38 @@ func resourceContainerGroup() *pluginsdk.Resource {
 	}
 }
 
+// resourceContainerGroupCustomizeDiff plans the names of the secure environment variables from the configuration, so
+// that where these differ from the names returned by the API (e.g. they've been changed out-of-band) the names show
+// up in the plan, rather than only as a change to the Sensitive `secure_environment_variables`
+func resourceContainerGroupCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+	containers := diff.Get("container").([]interface{})
+	for i := range containers {
+		if !diff.NewValueKnown(fmt.Sprintf("container.%d.name", i)) || !diff.NewValueKnown(fmt.Sprintf("container.%d.secure_environment_variables", i)) {
+			return diff.SetNewComputed("secure_environment_variable_names")
+		}
+	}
+
+	names := make([]interface{}, 0)
+	for _, raw := range containers {
+		if raw == nil {
+			continue
+		}
+		container := raw.(map[string]interface{})
+
+		secureEnvVarNames := make([]string, 0)
+		for name := range container["secure_environment_variables"].(map[string]interface{}) {
+			secureEnvVarNames = append(secureEnvVarNames, name)
+		}
+		sort.Strings(secureEnvVarNames)
+		names = append(names, map[string]interface{}{
+			"container_name": container["name"].(string),
+			"names":          utils.FlattenStringSlice(&secureEnvVarNames),
+		})
+	}
+
+	return diff.SetNew("secure_environment_variable_names", names)
+}
+
 func resourceContainerGroupCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Containers.GroupsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
@@ -546,15 +696,17 @@ func resourceContainerGroupCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	resGroup := d.Get("resource_group_name").(string)
 	name := d.Get("name").(string)
 
+	var existing containerinstance.ContainerGroup
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, resGroup, name)
+		var err error
+		existing, err = client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
 				return fmt.Errorf("checking for presence of existing Container Group %q (Resource Group %q): %s", name, resGroup, err)
 			}
 		}
 
-		if existing.ID != nil && *existing.ID != "" {
+		if existing.ID != nil && *existing.ID != "" && !meta.(*clients.Client).Features.ContainerGroup.AdoptExisting {
 			return tf.ImportAsExistsError("azurerm_container_group", *existing.ID)
 		}
 	}
@@ -571,6 +723,15 @@ func resourceContainerGroupCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	if err != nil {
 		return err
 	}
+
+	if d.Get("inject_msi_endpoint_env").(bool) {
+		clientId, err := retrieveContainerGroupUserAssignedIdentityClientId(ctx, meta, d)
+		if err != nil {
+			return err
+		}
+
+		injectContainerGroupEnvironmentVariable(containers, containerGroupAzureClientIdEnvironmentVariable, clientId)
+	}
 	containerGroup := containerinstance.ContainerGroup{
 		Name:     &name,
 		Location: &location,
@@ -606,6 +767,18 @@ func resourceContainerGroupCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
+	// the `adopt_existing` feature allows a Container Group left behind by a previous (partially failed) run
+	// to be taken over, providing it matches the configuration - otherwise it needs to be imported
+	if existing.ID != nil && *existing.ID != "" {
+		if err := containerGroupMatchesExisting(containerGroup, existing); err != nil {
+			return fmt.Errorf("adopting existing Container Group %q (Resource Group %q): %+v - this Container Group must be imported into the State or updated to match the configuration", name, resGroup, err)
+		}
+
+		log.Printf("[DEBUG] Adopting existing Container Group %q (Resource Group %q) into the State", name, resGroup)
+		d.SetId(*existing.ID)
+		return resourceContainerGroupRead(d, meta)
+	}
+
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, containerGroup)
 	if err != nil {
 		return fmt.Errorf("creating/updating container group %q (Resource Group %q): %+v", name, resGroup, err)
@@ -674,6 +847,7 @@ func resourceContainerGroupRead(d *pluginsdk.ResourceData, meta interface{}) err
 
 	d.Set("name", id.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("subscription_id", id.SubscriptionId)
 	if location := resp.Location; location != nil {
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
@@ -687,6 +861,10 @@ func resourceContainerGroupRead(d *pluginsdk.ResourceData, meta interface{}) err
 	}
 
 	if props := resp.ContainerGroupProperties; props != nil {
+		// this isn't returned from the API, so it's taken from the config/state (and is `false` on import) - this
+		// needs to be set prior to flattening the containers, since the injected Environment Variable is omitted
+		d.Set("inject_msi_endpoint_env", d.Get("inject_msi_endpoint_env").(bool))
+
 		containerConfigs := flattenContainerGroupContainers(d, resp.Containers, props.Volumes)
 		if err := d.Set("container", containerConfigs); err != nil {
 			return fmt.Errorf("setting `container`: %+v", err)
@@ -715,6 +893,20 @@ func resourceContainerGroupRead(d *pluginsdk.ResourceData, meta interface{}) err
 		if err := d.Set("diagnostics", flattenContainerGroupDiagnostics(d, props.Diagnostics)); err != nil {
 			return fmt.Errorf("setting `diagnostics`: %+v", err)
 		}
+
+		d.Set("last_restart_at", flattenContainerGroupLastRestartAt(props.Containers))
+
+		if err := d.Set("secure_environment_variable_names", flattenContainerGroupSecureEnvironmentVariableNames(props.Containers)); err != nil {
+			return fmt.Errorf("setting `secure_environment_variable_names`: %+v", err)
+		}
+	}
+
+	summary, err := flattenContainerGroupSummary(resp)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("summary", summary); err != nil {
+		return fmt.Errorf("setting `summary`: %+v", err)
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
@@ -1073,6 +1265,63 @@ func expandContainerGroupIdentity(d *pluginsdk.ResourceData) *containerinstance.
 	return &cgIdentity
 }
 
+// retrieveContainerGroupUserAssignedIdentityClientId returns the Client ID of the single User Assigned Identity
+// assigned to this Container Group, which is what the Azure SDKs need to authenticate using that identity
+func retrieveContainerGroupUserAssignedIdentityClientId(ctx context.Context, meta interface{}, d *pluginsdk.ResourceData) (string, error) {
+	client := meta.(*clients.Client).MSI.UserAssignedIdentitiesClient
+
+	identityIds := make([]interface{}, 0)
+	if identities := d.Get("identity").([]interface{}); len(identities) > 0 && identities[0] != nil {
+		identity := identities[0].(map[string]interface{})
+		identityIds = identity["identity_ids"].([]interface{})
+	}
+	if len(identityIds) != 1 {
+		return "", fmt.Errorf("`inject_msi_endpoint_env` requires exactly one User Assigned Identity to be specified within the `identity` block but got %d", len(identityIds))
+	}
+
+	id, err := msiparse.UserAssignedIdentityID(identityIds[0].(string))
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := client.UserAssignedIdentitiesGet(ctx, *id)
+	if err != nil {
+		return "", fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.ClientId == nil {
+		return "", fmt.Errorf("retrieving %s: `properties.clientId` was nil", *id)
+	}
+
+	return *resp.Model.Properties.ClientId, nil
+}
+
+// injectContainerGroupEnvironmentVariable adds the specified Environment Variable to each container which
+// doesn't already define it, so that any explicitly configured value takes precedence
+func injectContainerGroupEnvironmentVariable(containers *[]containerinstance.Container, name, value string) {
+	for _, container := range *containers {
+		if container.ContainerProperties == nil || container.EnvironmentVariables == nil {
+			continue
+		}
+
+		exists := false
+		for _, envVar := range *container.EnvironmentVariables {
+			if envVar.Name != nil && *envVar.Name == name {
+				exists = true
+				break
+			}
+		}
+		if exists {
+			continue
+		}
+
+		*container.EnvironmentVariables = append(*container.EnvironmentVariables, containerinstance.EnvironmentVariable{
+			Name:  utils.String(name),
+			Value: utils.String(value),
+		})
+	}
+}
+
 func expandContainerImageRegistryCredentials(d *pluginsdk.ResourceData) *[]containerinstance.ImageRegistryCredential {
 	credsRaw := d.Get("image_registry_credential").([]interface{})
 	if len(credsRaw) == 0 {
@@ -1296,6 +1545,135 @@ func flattenContainerGroupIdentity(identity *containerinstance.ContainerGroupIde
 	return []interface{}{result}, nil
 }
 
+// flattenContainerGroupSecureEnvironmentVariableNames returns the names of the secure environment variables for each
+// container, which are the environment variables returned by the API without a value
+func flattenContainerGroupSecureEnvironmentVariableNames(input *[]containerinstance.Container) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, container := range *input {
+		if container.Name == nil {
+			continue
+		}
+
+		names := make([]string, 0)
+		if props := container.ContainerProperties; props != nil && props.EnvironmentVariables != nil {
+			for _, envVar := range *props.EnvironmentVariables {
+				if envVar.Name != nil && envVar.Value == nil {
+					names = append(names, *envVar.Name)
+				}
+			}
+		}
+		sort.Strings(names)
+
+		output = append(output, map[string]interface{}{
+			"container_name": *container.Name,
+			"names":          utils.FlattenStringSlice(&names),
+		})
+	}
+
+	return output
+}
+
+// flattenContainerGroupLastRestartAt returns when a container within the Container Group was last restarted, which
+// is the start time of the most recently started container which has been restarted
+func flattenContainerGroupLastRestartAt(input *[]containerinstance.Container) string {
+	if input == nil {
+		return ""
+	}
+
+	var lastRestartAt *time.Time
+	for _, container := range *input {
+		if container.ContainerProperties == nil {
+			continue
+		}
+		view := container.InstanceView
+		if view == nil || view.RestartCount == nil || *view.RestartCount == 0 || view.CurrentState == nil || view.CurrentState.StartTime == nil {
+			continue
+		}
+		if lastRestartAt == nil || view.CurrentState.StartTime.Time.After(*lastRestartAt) {
+			lastRestartAt = &view.CurrentState.StartTime.Time
+		}
+	}
+
+	if lastRestartAt == nil {
+		return ""
+	}
+	return lastRestartAt.Format(time.RFC3339)
+}
+
+// flattenContainerGroupSummary builds the `summary` attribute, which is intended to be passed to downstream modules
+// as a single value - as such every field is always set and lists are sorted, so that the shape is stable
+func flattenContainerGroupSummary(input containerinstance.ContainerGroup) ([]interface{}, error) {
+	ipAddress := ""
+	fqdn := ""
+	ports := make([]interface{}, 0)
+	containers := make([]interface{}, 0)
+	if props := input.ContainerGroupProperties; props != nil {
+		if address := props.IPAddress; address != nil {
+			ipAddress = utils.NormalizeNilableString(address.IP)
+			fqdn = utils.NormalizeNilableString(address.Fqdn)
+
+			if address.Ports != nil {
+				for _, port := range *address.Ports {
+					portNumber := 0
+					if port.Port != nil {
+						portNumber = int(*port.Port)
+					}
+					ports = append(ports, map[string]interface{}{
+						"port":     portNumber,
+						"protocol": string(port.Protocol),
+					})
+				}
+				sort.SliceStable(ports, func(i, j int) bool {
+					first := ports[i].(map[string]interface{})
+					second := ports[j].(map[string]interface{})
+					if first["port"].(int) == second["port"].(int) {
+						return first["protocol"].(string) < second["protocol"].(string)
+					}
+					return first["port"].(int) < second["port"].(int)
+				})
+			}
+		}
+
+		if props.Containers != nil {
+			for _, container := range *props.Containers {
+				containers = append(containers, map[string]interface{}{
+					"name":  utils.NormalizeNilableString(container.Name),
+					"image": utils.NormalizeNilableString(container.Image),
+				})
+			}
+		}
+	}
+
+	principalId := ""
+	identityIds := make([]string, 0)
+	if identity := input.Identity; identity != nil {
+		principalId = utils.NormalizeNilableString(identity.PrincipalID)
+		for key := range identity.UserAssignedIdentities {
+			parsedId, err := msiparse.UserAssignedIdentityID(key)
+			if err != nil {
+				return nil, err
+			}
+			identityIds = append(identityIds, parsedId.ID())
+		}
+		sort.Strings(identityIds)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"ip_address":   ipAddress,
+			"fqdn":         fqdn,
+			"ports":        ports,
+			"principal_id": principalId,
+			"identity_ids": identityIds,
+			"containers":   containers,
+		},
+	}, nil
+}
+
 func flattenContainerImageRegistryCredentials(d *pluginsdk.ResourceData, input *[]containerinstance.ImageRegistryCredential) []interface{} {
 	if input == nil {
 		return nil
@@ -1380,7 +1758,16 @@ func flattenContainerGroupContainers(d *pluginsdk.ResourceData, containers *[]co
 
 		if container.EnvironmentVariables != nil {
 			if len(*container.EnvironmentVariables) > 0 {
-				containerConfig["environment_variables"] = flattenContainerEnvironmentVariables(container.EnvironmentVariables, false, d, index)
+				envVars := flattenContainerEnvironmentVariables(container.EnvironmentVariables, false, d, index)
+
+				// the Client ID injected by the provider isn't part of the users config, unless it's been set explicitly
+				if d.Get("inject_msi_endpoint_env").(bool) {
+					if _, ok := d.GetOk(fmt.Sprintf("container.%d.environment_variables.%s", index, containerGroupAzureClientIdEnvironmentVariable)); !ok {
+						delete(envVars, containerGroupAzureClientIdEnvironmentVariable)
+					}
+				}
+
+				containerConfig["environment_variables"] = envVars
 			}
 		}
 
@@ -1744,3 +2131,311 @@ func expandContainerGroupDnsConfig(input interface{}) *containerinstance.DNSConf
 
 	return nil
 }
+
+// containerGroupMatchesExisting confirms that the Container Group which would be created from the configuration
+// matches the existing Container Group for each of the fields which can't be changed without recreating it, such
+// that adopting the existing Container Group doesn't result in it being replaced during the next plan.
+//
+// The API doesn't return sensitive values (such as the values of Secure Environment Variables, Secret Volumes,
+// Registry Credential passwords or the Log Analytics Workspace Key) - as such only their presence is compared.
+func containerGroupMatchesExisting(expected, existing containerinstance.ContainerGroup) error {
+	if existing.ContainerGroupProperties == nil {
+		return fmt.Errorf("`properties` was nil")
+	}
+
+	expectedFields := containerGroupForceNewFields(expected)
+	existingFields := containerGroupForceNewFields(existing)
+
+	fieldNames := make([]string, 0, len(expectedFields))
+	for k := range expectedFields {
+		fieldNames = append(fieldNames, k)
+	}
+	for k := range existingFields {
+		if _, ok := expectedFields[k]; !ok {
+			fieldNames = append(fieldNames, k)
+		}
+	}
+	sort.Strings(fieldNames)
+
+	for _, field := range fieldNames {
+		expectedValue, existingValue := expectedFields[field], existingFields[field]
+		if !reflect.DeepEqual(expectedValue, existingValue) {
+			return fmt.Errorf("`%s` differs - expected %q but got %q", field, expectedValue, existingValue)
+		}
+	}
+
+	return nil
+}
+
+// containerGroupForceNewFields returns a normalized representation of the ForceNew fields of the Container Group,
+// keyed by the name of the field in the schema, which can be compared to determine if two Container Groups match
+func containerGroupForceNewFields(input containerinstance.ContainerGroup) map[string][]string {
+	output := map[string][]string{
+		"location": {azure.NormalizeLocation(utils.NormalizeNilableString(input.Location))},
+	}
+
+	identityType := ""
+	identityIds := make([]string, 0)
+	if identity := input.Identity; identity != nil && identity.Type != containerinstance.None {
+		identityType = strings.ToLower(string(identity.Type))
+		for k := range identity.UserAssignedIdentities {
+			identityIds = append(identityIds, strings.ToLower(k))
+		}
+	}
+	sort.Strings(identityIds)
+	output["identity.type"] = []string{identityType}
+	output["identity.identity_ids"] = identityIds
+
+	props := input.ContainerGroupProperties
+	if props == nil {
+		return output
+	}
+
+	output["os_type"] = []string{strings.ToLower(string(props.OsType))}
+	output["restart_policy"] = []string{strings.ToLower(string(props.RestartPolicy))}
+
+	ipAddressType := ""
+	dnsNameLabel := ""
+	exposedPorts := make([]string, 0)
+	if ip := props.IPAddress; ip != nil {
+		ipAddressType = strings.ToLower(string(ip.Type))
+		dnsNameLabel = strings.ToLower(utils.NormalizeNilableString(ip.DNSNameLabel))
+		if ip.Ports != nil {
+			for _, port := range *ip.Ports {
+				exposedPorts = append(exposedPorts, containerGroupPortFingerprint(port.Port, string(port.Protocol)))
+			}
+		}
+	}
+	sort.Strings(exposedPorts)
+	output["ip_address_type"] = []string{ipAddressType}
+	output["dns_name_label"] = []string{dnsNameLabel}
+	output["exposed_port"] = exposedPorts
+
+	networkProfileId := ""
+	if profile := props.NetworkProfile; profile != nil {
+		networkProfileId = strings.ToLower(utils.NormalizeNilableString(profile.ID))
+	}
+	output["network_profile_id"] = []string{networkProfileId}
+
+	registryCredentials := make([]string, 0)
+	if props.ImageRegistryCredentials != nil {
+		for _, credential := range *props.ImageRegistryCredentials {
+			registryCredentials = append(registryCredentials, fmt.Sprintf("%s/%s", strings.ToLower(utils.NormalizeNilableString(credential.Server)), utils.NormalizeNilableString(credential.Username)))
+		}
+	}
+	sort.Strings(registryCredentials)
+	output["image_registry_credential"] = registryCredentials
+
+	dnsConfig := make([]string, 0)
+	if config := props.DNSConfig; config != nil {
+		nameServers := make([]string, 0)
+		if config.NameServers != nil {
+			nameServers = *config.NameServers
+		}
+		dnsConfig = append(dnsConfig,
+			fmt.Sprintf("nameservers=%s", strings.Join(nameServers, ",")),
+			fmt.Sprintf("search_domains=%s", utils.NormalizeNilableString(config.SearchDomains)),
+			fmt.Sprintf("options=%s", utils.NormalizeNilableString(config.Options)))
+	}
+	output["dns_config"] = dnsConfig
+
+	diagnostics := make([]string, 0)
+	if v := props.Diagnostics; v != nil && v.LogAnalytics != nil {
+		metadata := make([]string, 0)
+		for k, v := range v.LogAnalytics.Metadata {
+			metadata = append(metadata, fmt.Sprintf("%s=%s", k, utils.NormalizeNilableString(v)))
+		}
+		sort.Strings(metadata)
+		diagnostics = append(diagnostics,
+			fmt.Sprintf("workspace_id=%s", utils.NormalizeNilableString(v.LogAnalytics.WorkspaceID)),
+			fmt.Sprintf("log_type=%s", string(v.LogAnalytics.LogType)),
+			fmt.Sprintf("metadata=%s", strings.Join(metadata, ",")))
+	}
+	output["diagnostics"] = diagnostics
+
+	volumes := make(map[string]containerinstance.Volume)
+	if props.Volumes != nil {
+		for _, volume := range *props.Volumes {
+			if volume.Name != nil {
+				volumes[*volume.Name] = volume
+			}
+		}
+	}
+
+	containerNames := make([]string, 0)
+	if props.Containers != nil {
+		for _, container := range *props.Containers {
+			if container.Name == nil {
+				continue
+			}
+			containerNames = append(containerNames, *container.Name)
+
+			for k, v := range containerGroupContainerForceNewFields(container, volumes) {
+				output[fmt.Sprintf("container.%s.%s", *container.Name, k)] = v
+			}
+		}
+	}
+	sort.Strings(containerNames)
+	output["container"] = containerNames
+
+	return output
+}
+
+func containerGroupContainerForceNewFields(container containerinstance.Container, volumes map[string]containerinstance.Volume) map[string][]string {
+	output := make(map[string][]string)
+	props := container.ContainerProperties
+	if props == nil {
+		return output
+	}
+
+	output["image"] = []string{strings.ToLower(utils.NormalizeNilableString(props.Image))}
+
+	cpu, memory := containerGroupContainerRequests(container)
+	output["cpu"] = []string{strconv.FormatFloat(cpu, 'f', -1, 64)}
+	output["memory"] = []string{strconv.FormatFloat(memory, 'f', -1, 64)}
+
+	gpu := make([]string, 0)
+	if resources := props.Resources; resources != nil && resources.Requests != nil && resources.Requests.Gpu != nil {
+		count := int32(0)
+		if resources.Requests.Gpu.Count != nil {
+			count = *resources.Requests.Gpu.Count
+		}
+		gpu = append(gpu, fmt.Sprintf("%d/%s", count, strings.ToLower(string(resources.Requests.Gpu.Sku))))
+	}
+	output["gpu"] = gpu
+
+	ports := make([]string, 0)
+	if props.Ports != nil {
+		for _, port := range *props.Ports {
+			ports = append(ports, containerGroupPortFingerprint(port.Port, string(port.Protocol)))
+		}
+	}
+	sort.Strings(ports)
+	output["ports"] = ports
+
+	commands := make([]string, 0)
+	if props.Command != nil {
+		commands = append(commands, *props.Command...)
+	}
+	output["commands"] = commands
+
+	environmentVariables := make([]string, 0)
+	secureEnvironmentVariables := make([]string, 0)
+	if props.EnvironmentVariables != nil {
+		for _, envVar := range *props.EnvironmentVariables {
+			if envVar.Name == nil {
+				continue
+			}
+
+			// the API doesn't return the value for Secure Environment Variables
+			if envVar.Value == nil {
+				secureEnvironmentVariables = append(secureEnvironmentVariables, *envVar.Name)
+				continue
+			}
+			environmentVariables = append(environmentVariables, fmt.Sprintf("%s=%s", *envVar.Name, *envVar.Value))
+		}
+	}
+	sort.Strings(environmentVariables)
+	sort.Strings(secureEnvironmentVariables)
+	output["environment_variables"] = environmentVariables
+	output["secure_environment_variables"] = secureEnvironmentVariables
+
+	volumeMounts := make([]string, 0)
+	if props.VolumeMounts != nil {
+		for _, mount := range *props.VolumeMounts {
+			name := utils.NormalizeNilableString(mount.Name)
+			readOnly := mount.ReadOnly != nil && *mount.ReadOnly
+			volumeMounts = append(volumeMounts, fmt.Sprintf("%s:%s:%t:%s", name, utils.NormalizeNilableString(mount.MountPath), readOnly, containerGroupVolumeFingerprint(volumes[name])))
+		}
+	}
+	sort.Strings(volumeMounts)
+	output["volume"] = volumeMounts
+
+	output["liveness_probe"] = containerGroupProbeFingerprint(props.LivenessProbe)
+	output["readiness_probe"] = containerGroupProbeFingerprint(props.ReadinessProbe)
+
+	return output
+}
+
+func containerGroupPortFingerprint(port *int32, protocol string) string {
+	portNumber := int32(0)
+	if port != nil {
+		portNumber = *port
+	}
+	if protocol == "" {
+		protocol = string(containerinstance.TCP)
+	}
+	return fmt.Sprintf("%d/%s", portNumber, strings.ToUpper(protocol))
+}
+
+func containerGroupVolumeFingerprint(volume containerinstance.Volume) string {
+	switch {
+	case volume.AzureFile != nil:
+		readOnly := volume.AzureFile.ReadOnly != nil && *volume.AzureFile.ReadOnly
+		return fmt.Sprintf("azure_file=%s/%s/%t", utils.NormalizeNilableString(volume.AzureFile.StorageAccountName), utils.NormalizeNilableString(volume.AzureFile.ShareName), readOnly)
+
+	case volume.GitRepo != nil:
+		return fmt.Sprintf("git_repo=%s/%s/%s", utils.NormalizeNilableString(volume.GitRepo.Repository), utils.NormalizeNilableString(volume.GitRepo.Directory), utils.NormalizeNilableString(volume.GitRepo.Revision))
+
+	case volume.Secret != nil:
+		// the API doesn't return the values for Secret Volumes
+		keys := make([]string, 0, len(volume.Secret))
+		for k := range volume.Secret {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		return fmt.Sprintf("secret=%s", strings.Join(keys, ","))
+
+	case volume.EmptyDir != nil:
+		return "empty_dir"
+	}
+
+	return ""
+}
+
+func containerGroupProbeFingerprint(probe *containerinstance.ContainerProbe) []string {
+	output := make([]string, 0)
+	if probe == nil {
+		return output
+	}
+
+	if probe.Exec != nil && probe.Exec.Command != nil {
+		output = append(output, fmt.Sprintf("exec=%s", strings.Join(*probe.Exec.Command, " ")))
+	}
+	if httpGet := probe.HTTPGet; httpGet != nil {
+		port := int32(0)
+		if httpGet.Port != nil {
+			port = *httpGet.Port
+		}
+		output = append(output, fmt.Sprintf("http_get=%s/%s/%d", strings.ToLower(string(httpGet.Scheme)), utils.NormalizeNilableString(httpGet.Path), port))
+	}
+
+	for name, v := range map[string]*int32{
+		"initial_delay_seconds": probe.InitialDelaySeconds,
+		"period_seconds":        probe.PeriodSeconds,
+		"failure_threshold":     probe.FailureThreshold,
+		"success_threshold":     probe.SuccessThreshold,
+		"timeout_seconds":       probe.TimeoutSeconds,
+	} {
+		value := int32(0)
+		if v != nil {
+			value = *v
+		}
+		output = append(output, fmt.Sprintf("%s=%d", name, value))
+	}
+	sort.Strings(output)
+
+	return output
+}
+
+func containerGroupContainerRequests(container containerinstance.Container) (cpu float64, memory float64) {
+	if resources := container.Resources; resources != nil && resources.Requests != nil {
+		if resources.Requests.CPU != nil {
+			cpu = *resources.Requests.CPU
+		}
+		if resources.Requests.MemoryInGB != nil {
+			memory = *resources.Requests.MemoryInGB
+		}
+	}
+	return
+}
@@ -3,8 +3,11 @@ package containers_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
+	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/containerinstance/mgmt/2019-12-01/containerinstance"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
@@ -47,12 +50,31 @@ func TestAccContainerGroup_UserAssignedIdentity(t *testing.T) {
 				check.That(data.ResourceName).Key("identity.0.type").HasValue("UserAssigned"),
 				check.That(data.ResourceName).Key("identity.0.identity_ids.#").HasValue("1"),
 				check.That(data.ResourceName).Key("identity.0.principal_id").HasValue(""),
+				check.That(data.ResourceName).Key("summary.0.identity_ids.#").HasValue("1"),
 			),
 		},
 		data.ImportStep(),
 	})
 }
 
+func TestAccContainerGroup_injectMsiEndpointEnv(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_group", "test")
+	r := ContainerGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.injectMsiEndpointEnv(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("inject_msi_endpoint_env").HasValue("true"),
+				check.That(data.ResourceName).Key("container.0.environment_variables.%").HasValue("0"),
+			),
+		},
+		// `inject_msi_endpoint_env` isn't returned by the API, so the injected Environment Variable is imported
+		data.ImportStep("inject_msi_endpoint_env", "container.0.environment_variables"),
+	})
+}
+
 func TestAccContainerGroup_multipleAssignedIdentities(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_container_group", "test")
 	r := ContainerGroupResource{}
@@ -149,6 +171,31 @@ func TestAccContainerGroup_logTypeUnset(t *testing.T) {
 	})
 }
 
+func TestAccContainerGroup_adoptExisting(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_group", "test")
+	r := ContainerGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.adoptExistingTemplate(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				data.CheckWithClientForResource(r.createOutOfBand(data), "azurerm_resource_group.test"),
+			),
+		},
+		{
+			Config: r.adoptExisting(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			// adopting the Container Group mustn't result in it being replaced
+			Config:   r.adoptExisting(data),
+			PlanOnly: true,
+		},
+	})
+}
+
 func TestAccContainerGroup_linuxBasic(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_container_group", "test")
 	r := ContainerGroupResource{}
@@ -161,15 +208,45 @@ func TestAccContainerGroup_linuxBasic(t *testing.T) {
 				check.That(data.ResourceName).Key("container.#").HasValue("1"),
 				check.That(data.ResourceName).Key("os_type").HasValue("Linux"),
 				check.That(data.ResourceName).Key("container.0.ports.#").HasValue("1"),
+				check.That(data.ResourceName).Key("subscription_id").Exists(),
+				check.That(data.ResourceName).Key("summary.#").HasValue("1"),
+				check.That(data.ResourceName).Key("summary.0.ip_address").Exists(),
+				check.That(data.ResourceName).Key("summary.0.containers.#").HasValue("1"),
+				check.That(data.ResourceName).Key("summary.0.identity_ids.#").HasValue("0"),
 			),
 		},
 		data.ImportStep(
 			"image_registry_credential.0.password",
 			"image_registry_credential.1.password",
+			// the container exits immediately and is restarted, so this can change between refreshes
+			"last_restart_at",
 		),
 	})
 }
 
+func TestAccContainerGroup_lastRestartAt(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_group", "test")
+	r := ContainerGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.restartingContainer(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			// the container exits after 30 seconds, so wait for it to have been restarted before refreshing
+			PreConfig: func() { time.Sleep(3 * time.Minute) },
+			Config:    r.restartingContainer(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				acceptance.TestMatchResourceAttr(data.ResourceName, "last_restart_at", regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T`)),
+			),
+		},
+	})
+}
+
 func TestAccContainerGroup_exposedPort(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_container_group", "test")
 	r := ContainerGroupResource{}
@@ -299,6 +376,9 @@ func TestAccContainerGroup_linuxComplete(t *testing.T) {
 				check.That(data.ResourceName).Key("container.0.secure_environment_variables.%").HasValue("2"),
 				check.That(data.ResourceName).Key("container.0.secure_environment_variables.secureFoo").HasValue("secureBar"),
 				check.That(data.ResourceName).Key("container.0.secure_environment_variables.secureFoo1").HasValue("secureBar1"),
+				check.That(data.ResourceName).Key("secure_environment_variable_names.#").HasValue("1"),
+				check.That(data.ResourceName).Key("secure_environment_variable_names.0.names.#").HasValue("2"),
+				check.That(data.ResourceName).Key("secure_environment_variable_names.0.names.0").HasValue("secureFoo"),
 				check.That(data.ResourceName).Key("container.0.gpu.#").HasValue("1"),
 				check.That(data.ResourceName).Key("container.0.gpu.0.count").HasValue("1"),
 				check.That(data.ResourceName).Key("container.0.gpu.0.sku").HasValue("K80"),
@@ -364,6 +444,7 @@ func TestAccContainerGroup_virtualNetwork(t *testing.T) {
 				check.That(data.ResourceName).Key("dns_config.#").HasValue("1"),
 			),
 		},
+		data.ImportStep(),
 	})
 }
 
@@ -609,6 +690,50 @@ resource "azurerm_container_group" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomInteger)
 }
 
+func (ContainerGroupResource) injectMsiEndpointEnv(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctest%s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_container_group" "test" {
+  name                    = "acctestcontainergroup-%d"
+  location                = azurerm_resource_group.test.location
+  resource_group_name     = azurerm_resource_group.test.name
+  ip_address_type         = "public"
+  os_type                 = "Linux"
+  inject_msi_endpoint_env = true
+
+  container {
+    name   = "hw"
+    image  = "ubuntu:20.04"
+    cpu    = "0.5"
+    memory = "0.5"
+    ports {
+      port     = 80
+      protocol = "TCP"
+    }
+  }
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomInteger)
+}
+
 func (ContainerGroupResource) MultipleAssignedIdentities(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -657,6 +782,167 @@ resource "azurerm_container_group" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomInteger)
 }
 
+func (ContainerGroupResource) createOutOfBand(data acceptance.TestData) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+		client := clients.Containers.GroupsClient
+		resourceGroup := state.Attributes["name"]
+		name := fmt.Sprintf("acctestcontainergroup-%d", data.RandomInteger)
+
+		parameters := containerinstance.ContainerGroup{
+			Location: utils.String(state.Attributes["location"]),
+			ContainerGroupProperties: &containerinstance.ContainerGroupProperties{
+				OsType:        containerinstance.Linux,
+				RestartPolicy: containerinstance.Always,
+				IPAddress: &containerinstance.IPAddress{
+					Type: containerinstance.Public,
+					Ports: &[]containerinstance.Port{
+						{
+							Port:     utils.Int32(80),
+							Protocol: containerinstance.TCP,
+						},
+					},
+				},
+				Containers: &[]containerinstance.Container{
+					{
+						Name: utils.String("hw"),
+						ContainerProperties: &containerinstance.ContainerProperties{
+							Image:   utils.String("ubuntu:20.04"),
+							Command: &[]string{"/bin/bash", "-c", "sleep infinity"},
+							Ports: &[]containerinstance.ContainerPort{
+								{
+									Port:     utils.Int32(80),
+									Protocol: containerinstance.ContainerNetworkProtocolTCP,
+								},
+							},
+							EnvironmentVariables: &[]containerinstance.EnvironmentVariable{
+								{
+									Name:  utils.String("FOO"),
+									Value: utils.String("bar"),
+								},
+								{
+									Name:        utils.String("SECURE_FOO"),
+									SecureValue: utils.String("secure-bar"),
+								},
+							},
+							Resources: &containerinstance.ResourceRequirements{
+								Requests: &containerinstance.ResourceRequests{
+									CPU:        utils.Float(0.5),
+									MemoryInGB: utils.Float(0.5),
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+		if err != nil {
+			return fmt.Errorf("creating Container Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for creation of Container Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		return nil
+	}
+}
+
+func (ContainerGroupResource) adoptExistingTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (ContainerGroupResource) adoptExisting(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    container_group {
+      adopt_existing = true
+    }
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_group" "test" {
+  name                = "acctestcontainergroup-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  ip_address_type     = "Public"
+  os_type             = "Linux"
+  restart_policy      = "Always"
+
+  container {
+    name     = "hw"
+    image    = "ubuntu:20.04"
+    cpu      = "0.5"
+    memory   = "0.5"
+    commands = ["/bin/bash", "-c", "sleep infinity"]
+
+    ports {
+      port     = 80
+      protocol = "TCP"
+    }
+
+    environment_variables = {
+      FOO = "bar"
+    }
+
+    secure_environment_variables = {
+      SECURE_FOO = "secure-bar"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (ContainerGroupResource) restartingContainer(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_group" "test" {
+  name                = "acctestcontainergroup-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  ip_address_type     = "Public"
+  os_type             = "Linux"
+  restart_policy      = "Always"
+
+  container {
+    name     = "hw"
+    image    = "ubuntu:20.04"
+    cpu      = "0.5"
+    memory   = "0.5"
+    commands = ["/bin/bash", "-c", "sleep 30; exit 1"]
+
+    ports {
+      port     = 80
+      protocol = "TCP"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
 func (ContainerGroupResource) linuxBasic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
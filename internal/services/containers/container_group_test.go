@@ -0,0 +1,319 @@
+package containers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerinstance/mgmt/2019-12-01/containerinstance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestContainerGroupMatchesExisting(t *testing.T) {
+	testData := []struct {
+		name     string
+		modify   func(input *containerinstance.ContainerGroup)
+		expected bool
+	}{
+		{
+			name:     "identical",
+			modify:   func(input *containerinstance.ContainerGroup) {},
+			expected: true,
+		},
+		{
+			name: "secure environment variable values aren't returned",
+			modify: func(input *containerinstance.ContainerGroup) {
+				(*(*input.Containers)[0].EnvironmentVariables)[1].SecureValue = nil
+			},
+			expected: true,
+		},
+		{
+			name: "casing of the location and network profile",
+			modify: func(input *containerinstance.ContainerGroup) {
+				input.Location = utils.String("West Europe")
+				input.NetworkProfile.ID = utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/Microsoft.Network/networkProfiles/profile1")
+			},
+			expected: true,
+		},
+		{
+			name: "image",
+			modify: func(input *containerinstance.ContainerGroup) {
+				(*input.Containers)[0].Image = utils.String("ubuntu:22.04")
+			},
+			expected: false,
+		},
+		{
+			name: "environment variable value",
+			modify: func(input *containerinstance.ContainerGroup) {
+				(*(*input.Containers)[0].EnvironmentVariables)[0].Value = utils.String("other")
+			},
+			expected: false,
+		},
+		{
+			name: "secure environment variable removed",
+			modify: func(input *containerinstance.ContainerGroup) {
+				envVars := (*(*input.Containers)[0].EnvironmentVariables)[:1]
+				(*input.Containers)[0].EnvironmentVariables = &envVars
+			},
+			expected: false,
+		},
+		{
+			name: "ports",
+			modify: func(input *containerinstance.ContainerGroup) {
+				(*(*input.Containers)[0].Ports)[0].Port = utils.Int32(8080)
+			},
+			expected: false,
+		},
+		{
+			name: "commands",
+			modify: func(input *containerinstance.ContainerGroup) {
+				(*input.Containers)[0].Command = &[]string{"/bin/sh"}
+			},
+			expected: false,
+		},
+		{
+			name: "volume mount",
+			modify: func(input *containerinstance.ContainerGroup) {
+				(*(*input.Containers)[0].VolumeMounts)[0].ReadOnly = utils.Bool(true)
+			},
+			expected: false,
+		},
+		{
+			name: "liveness probe",
+			modify: func(input *containerinstance.ContainerGroup) {
+				(*input.Containers)[0].LivenessProbe.PeriodSeconds = utils.Int32(30)
+			},
+			expected: false,
+		},
+		{
+			name: "identity",
+			modify: func(input *containerinstance.ContainerGroup) {
+				input.Identity = &containerinstance.ContainerGroupIdentity{
+					Type: containerinstance.SystemAssigned,
+				}
+			},
+			expected: false,
+		},
+		{
+			name: "network profile",
+			modify: func(input *containerinstance.ContainerGroup) {
+				input.NetworkProfile = nil
+			},
+			expected: false,
+		},
+		{
+			name: "image registry credential",
+			modify: func(input *containerinstance.ContainerGroup) {
+				(*input.ImageRegistryCredentials)[0].Username = utils.String("other")
+			},
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		existing := testContainerGroupForAdoption()
+		v.modify(&existing)
+
+		err := containerGroupMatchesExisting(testContainerGroupForAdoption(), existing)
+		if v.expected && err != nil {
+			t.Fatalf("expected the Container Groups to match but got: %+v", err)
+		}
+		if !v.expected && err == nil {
+			t.Fatalf("expected the Container Groups not to match")
+		}
+	}
+}
+
+func testContainerGroupForAdoption() containerinstance.ContainerGroup {
+	return containerinstance.ContainerGroup{
+		Location: utils.String("westeurope"),
+		ContainerGroupProperties: &containerinstance.ContainerGroupProperties{
+			OsType:        containerinstance.Linux,
+			RestartPolicy: containerinstance.Always,
+			IPAddress: &containerinstance.IPAddress{
+				Type: containerinstance.Private,
+				Ports: &[]containerinstance.Port{
+					{
+						Port:     utils.Int32(80),
+						Protocol: containerinstance.TCP,
+					},
+				},
+			},
+			NetworkProfile: &containerinstance.ContainerGroupNetworkProfile{
+				ID: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkProfiles/profile1"),
+			},
+			ImageRegistryCredentials: &[]containerinstance.ImageRegistryCredential{
+				{
+					Server:   utils.String("example.azurecr.io"),
+					Username: utils.String("user"),
+				},
+			},
+			Volumes: &[]containerinstance.Volume{
+				{
+					Name: utils.String("data"),
+					AzureFile: &containerinstance.AzureFileVolume{
+						ShareName:          utils.String("share"),
+						StorageAccountName: utils.String("account"),
+					},
+				},
+			},
+			Containers: &[]containerinstance.Container{
+				{
+					Name: utils.String("hw"),
+					ContainerProperties: &containerinstance.ContainerProperties{
+						Image:   utils.String("ubuntu:20.04"),
+						Command: &[]string{"/bin/bash", "-c", "sleep infinity"},
+						Ports: &[]containerinstance.ContainerPort{
+							{
+								Port:     utils.Int32(80),
+								Protocol: containerinstance.ContainerNetworkProtocolTCP,
+							},
+						},
+						EnvironmentVariables: &[]containerinstance.EnvironmentVariable{
+							{
+								Name:  utils.String("FOO"),
+								Value: utils.String("bar"),
+							},
+							{
+								Name:        utils.String("SECURE_FOO"),
+								SecureValue: utils.String("secure-bar"),
+							},
+						},
+						Resources: &containerinstance.ResourceRequirements{
+							Requests: &containerinstance.ResourceRequests{
+								CPU:        utils.Float(0.5),
+								MemoryInGB: utils.Float(0.5),
+							},
+						},
+						VolumeMounts: &[]containerinstance.VolumeMount{
+							{
+								Name:      utils.String("data"),
+								MountPath: utils.String("/data"),
+								ReadOnly:  utils.Bool(false),
+							},
+						},
+						LivenessProbe: &containerinstance.ContainerProbe{
+							Exec: &containerinstance.ContainerExec{
+								Command: &[]string{"cat", "/tmp/healthy"},
+							},
+							PeriodSeconds: utils.Int32(10),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestContainerGroupSecureEnvironmentVariableNamesDrift(t *testing.T) {
+	testData := []struct {
+		name          string
+		existingNames []string
+		expectDiff    bool
+	}{
+		{
+			name:          "unchanged",
+			existingNames: []string{"SECURE_BAR", "SECURE_FOO"},
+			expectDiff:    false,
+		},
+		{
+			name:          "removed out-of-band",
+			existingNames: []string{"SECURE_FOO"},
+			expectDiff:    true,
+		},
+		{
+			name:          "added out-of-band",
+			existingNames: []string{"SECURE_BAR", "SECURE_BAZ", "SECURE_FOO"},
+			expectDiff:    true,
+		},
+		{
+			name:          "all removed out-of-band",
+			existingNames: []string{},
+			expectDiff:    true,
+		},
+	}
+
+	config := map[string]interface{}{
+		"name":                "example",
+		"resource_group_name": "example",
+		"location":            "westeurope",
+		"os_type":             "Linux",
+		"container": []interface{}{
+			map[string]interface{}{
+				"name":   "hw",
+				"image":  "ubuntu:20.04",
+				"cpu":    0.5,
+				"memory": 0.5,
+				"secure_environment_variables": map[string]interface{}{
+					"SECURE_FOO": "foo",
+					"SECURE_BAR": "bar",
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		resource := resourceContainerGroup()
+
+		// build the state as it'd be following a Read, where the API returns the specified secure environment variables
+		envVars := make([]containerinstance.EnvironmentVariable, 0)
+		for _, name := range v.existingNames {
+			envVars = append(envVars, containerinstance.EnvironmentVariable{
+				Name: utils.String(name),
+			})
+		}
+		containers := []containerinstance.Container{
+			{
+				Name: utils.String("hw"),
+				ContainerProperties: &containerinstance.ContainerProperties{
+					Image:                utils.String("ubuntu:20.04"),
+					EnvironmentVariables: &envVars,
+					Ports:                &[]containerinstance.ContainerPort{},
+					Resources: &containerinstance.ResourceRequirements{
+						Requests: &containerinstance.ResourceRequests{
+							CPU:        utils.Float(0.5),
+							MemoryInGB: utils.Float(0.5),
+						},
+					},
+				},
+			},
+		}
+		d := schema.TestResourceDataRaw(t, resource.Schema, config)
+		d.SetId("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.ContainerInstance/containerGroups/example")
+		if err := d.Set("container", flattenContainerGroupContainers(d, &containers, nil)); err != nil {
+			t.Fatalf("setting `container`: %+v", err)
+		}
+		if err := d.Set("secure_environment_variable_names", flattenContainerGroupSecureEnvironmentVariableNames(&containers)); err != nil {
+			t.Fatalf("setting `secure_environment_variable_names`: %+v", err)
+		}
+
+		diff, err := resource.Diff(context.TODO(), d.State(), terraform.NewResourceConfigRaw(config), nil)
+		if err != nil {
+			t.Fatalf("building diff: %+v", err)
+		}
+
+		namesChanged := false
+		if diff != nil {
+			for key, attr := range diff.Attributes {
+				if strings.HasPrefix(key, "secure_environment_variable_names.") && attr.Old != attr.New {
+					namesChanged = true
+				}
+			}
+		}
+		if namesChanged != v.expectDiff {
+			t.Fatalf("expected a diff for `secure_environment_variable_names` to be %t but got %t: %+v", v.expectDiff, namesChanged, diff)
+		}
+		if v.expectDiff && !diff.RequiresNew() {
+			t.Fatalf("expected the diff to require a new resource")
+		}
+		if v.expectDiff && diff.Attributes["secure_environment_variable_names.0.names.#"].New != "2" {
+			t.Fatalf("expected the planned `secure_environment_variable_names` to contain the 2 configured names but got %+v", diff)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/containerregistry/mgmt/2020-11-01-preview/containerregistry"
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -17,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
 	validate2 "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	logAnalyticsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/parse"
+	logAnalyticsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
 	identityParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
 	identityValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -124,6 +127,12 @@ func resourceContainerRegistry() *pluginsdk.Resource {
 				},
 			},
 
+			"georeplication_deletion_drain_duration": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate2.Duration,
+			},
+
 			"public_network_access_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -357,6 +366,34 @@ func resourceContainerRegistry() *pluginsdk.Resource {
 				Default: string(containerregistry.NetworkRuleBypassOptionsAzureServices),
 			},
 
+			"diagnostic_settings": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"log_analytics_workspace_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: logAnalyticsValidate.LogAnalyticsWorkspaceID,
+						},
+
+						"categories": {
+							Type:     pluginsdk.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									containerRegistryDiagnosticCategoryLoginEvents,
+									containerRegistryDiagnosticCategoryRepositoryEvents,
+								}, false),
+							},
+						},
+					},
+				},
+			},
+
 			"tags": tags.Schema(),
 		},
 
@@ -420,6 +457,7 @@ func resourceContainerRegistry() *pluginsdk.Resource {
 
 func resourceContainerRegistryCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Containers.RegistriesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	log.Printf("[INFO] preparing arguments for  Container Registry creation.")
@@ -539,6 +577,13 @@ func resourceContainerRegistryCreate(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
+	if v := d.Get("diagnostic_settings").([]interface{}); len(v) > 0 {
+		registryId := parse.NewRegistryID(subscriptionId, resourceGroup, name)
+		if err := applyContainerRegistryDiagnosticSettings(ctx, meta, registryId, v); err != nil {
+			return err
+		}
+	}
+
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
 		return fmt.Errorf("retrieving Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -555,6 +600,7 @@ func resourceContainerRegistryCreate(d *pluginsdk.ResourceData, meta interface{}
 
 func resourceContainerRegistryUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Containers.RegistriesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	log.Printf("[INFO] preparing arguments for  Container Registry update.")
@@ -661,6 +707,13 @@ func resourceContainerRegistryUpdate(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
+	if d.HasChange("diagnostic_settings") {
+		registryId := parse.NewRegistryID(subscriptionId, resourceGroup, name)
+		if err := applyContainerRegistryDiagnosticSettings(ctx, meta, registryId, d.Get("diagnostic_settings").([]interface{})); err != nil {
+			return err
+		}
+	}
+
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
 		return fmt.Errorf("retrieving Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -705,13 +758,36 @@ func applyGeoReplicationLocations(d *pluginsdk.ResourceData, meta interface{}, r
 	defer cancel()
 	log.Printf("[INFO] preparing to apply geo-replications for  Container Registry.")
 
-	// delete previously deployed locations
+	var drainDuration time.Duration
+	if v := d.Get("georeplication_deletion_drain_duration").(string); v != "" {
+		duration, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parsing `georeplication_deletion_drain_duration`: %+v", err)
+		}
+		drainDuration = duration
+	}
+
+	// delete previously deployed locations which are no longer required, or which can't be updated in-place
+	locationsToDelete := make([]string, 0)
 	for _, replication := range oldGeoReplications {
 		if replication.Location == nil {
 			continue
 		}
-		oldLocation := azure.NormalizeLocation(*replication.Location)
+		if !containerRegistryReplicationRequiresDeletion(replication, newGeoReplications) {
+			continue
+		}
+		locationsToDelete = append(locationsToDelete, azure.NormalizeLocation(*replication.Location))
+	}
+
+	// the regional endpoints are disabled for each of the replications up-front, so that they're drained at the same
+	// time - meaning removing multiple replications only waits for the drain duration once, rather than for each one
+	if drainDuration > 0 && len(locationsToDelete) > 0 {
+		if err := drainContainerRegistryReplications(ctx, replicationClient, resourceGroup, name, locationsToDelete, drainDuration); err != nil {
+			return err
+		}
+	}
 
+	for _, oldLocation := range locationsToDelete {
 		future, err := replicationClient.Delete(ctx, resourceGroup, name, oldLocation)
 		if err != nil {
 			return fmt.Errorf("deleting Container Registry Replication %q (Resource Group %q, Location %q): %+v", name, resourceGroup, oldLocation, err)
@@ -721,7 +797,7 @@ func applyGeoReplicationLocations(d *pluginsdk.ResourceData, meta interface{}, r
 		}
 	}
 
-	// create new geo-replication locations
+	// create new geo-replication locations, or update the existing ones in-place
 	for _, replication := range newGeoReplications {
 		if replication.Location == nil {
 			continue
@@ -740,6 +816,157 @@ func applyGeoReplicationLocations(d *pluginsdk.ResourceData, meta interface{}, r
 	return nil
 }
 
+// containerRegistryReplicationRequiresDeletion returns whether an existing replication needs to be deleted, either
+// because it's been removed or since the Zone Redundancy has changed, which can only be set when it's created
+func containerRegistryReplicationRequiresDeletion(existing containerregistry.Replication, newGeoReplications []containerregistry.Replication) bool {
+	existingLocation := azure.NormalizeLocation(*existing.Location)
+	for _, replication := range newGeoReplications {
+		if replication.Location == nil || azure.NormalizeLocation(*replication.Location) != existingLocation {
+			continue
+		}
+
+		return containerRegistryReplicationZoneRedundancy(replication) != containerRegistryReplicationZoneRedundancy(existing)
+	}
+
+	return true
+}
+
+func containerRegistryReplicationZoneRedundancy(replication containerregistry.Replication) containerregistry.ZoneRedundancy {
+	if props := replication.ReplicationProperties; props != nil && props.ZoneRedundancy != "" {
+		return props.ZoneRedundancy
+	}
+	return containerregistry.ZoneRedundancyDisabled
+}
+
+// drainContainerRegistryReplications disables the regional endpoint for each of the specified replications, so that
+// requests are no longer routed to them, and then waits for the specified duration to allow in-flight pulls to complete
+func drainContainerRegistryReplications(ctx context.Context, client *containerregistry.ReplicationsClient, resourceGroup, registryName string, locations []string, duration time.Duration) error {
+	parameters := containerregistry.ReplicationUpdateParameters{
+		ReplicationUpdateParametersProperties: &containerregistry.ReplicationUpdateParametersProperties{
+			RegionEndpointEnabled: utils.Bool(false),
+		},
+	}
+
+	futures := make(map[string]containerregistry.ReplicationsUpdateFuture)
+	for _, location := range locations {
+		log.Printf("[DEBUG] Disabling the regional endpoint for Container Registry Replication %q (Container Registry %q / Resource Group %q)..", location, registryName, resourceGroup)
+		future, err := client.Update(ctx, resourceGroup, registryName, location, parameters)
+		if err != nil {
+			return fmt.Errorf("disabling the regional endpoint for Container Registry Replication %q (Resource Group %q, Location %q): %+v", registryName, resourceGroup, location, err)
+		}
+		futures[location] = future
+	}
+	for location, future := range futures {
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for the regional endpoint to be disabled for Container Registry Replication %q (Resource Group %q, Location %q): %+v", registryName, resourceGroup, location, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Waiting %s for the Replications in %q of Container Registry %q (Resource Group %q) to drain..", duration, strings.Join(locations, ", "), registryName, resourceGroup)
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("waiting for the Replications of Container Registry %q (Resource Group %q) to drain: %+v", registryName, resourceGroup, ctx.Err())
+	case <-time.After(duration):
+	}
+
+	return nil
+}
+
+const (
+	// containerRegistryDiagnosticSettingName is the name of the Diagnostic Setting managed through the
+	// `diagnostic_settings` block, any other Diagnostic Settings on the Registry are left as-is
+	containerRegistryDiagnosticSettingName = "terraform-managed-audit-logs"
+
+	containerRegistryDiagnosticCategoryLoginEvents      = "ContainerRegistryLoginEvents"
+	containerRegistryDiagnosticCategoryRepositoryEvents = "ContainerRegistryRepositoryEvents"
+)
+
+func applyContainerRegistryDiagnosticSettings(ctx context.Context, meta interface{}, id parse.RegistryId, input []interface{}) error {
+	client := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+
+	// the Azure SDK prefixes the URI with a `/` such this makes a bad request if we don't trim the `/`
+	targetResourceId := strings.TrimPrefix(id.ID(), "/")
+
+	if len(input) == 0 || input[0] == nil {
+		log.Printf("[DEBUG] Removing Diagnostic Setting %q for %s..", containerRegistryDiagnosticSettingName, id)
+		resp, err := client.Delete(ctx, targetResourceId, containerRegistryDiagnosticSettingName)
+		if err != nil && !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("removing Diagnostic Setting %q for %s: %+v", containerRegistryDiagnosticSettingName, id, err)
+		}
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	logs := make([]insights.LogSettings, 0)
+	for _, category := range raw["categories"].(*pluginsdk.Set).List() {
+		logs = append(logs, insights.LogSettings{
+			Category: utils.String(category.(string)),
+			Enabled:  utils.Bool(true),
+		})
+	}
+
+	parameters := insights.DiagnosticSettingsResource{
+		DiagnosticSettings: &insights.DiagnosticSettings{
+			WorkspaceID: utils.String(raw["log_analytics_workspace_id"].(string)),
+			Logs:        &logs,
+		},
+	}
+
+	log.Printf("[DEBUG] Configuring Diagnostic Setting %q for %s..", containerRegistryDiagnosticSettingName, id)
+	if _, err := client.CreateOrUpdate(ctx, targetResourceId, parameters, containerRegistryDiagnosticSettingName); err != nil {
+		return fmt.Errorf("configuring Diagnostic Setting %q for %s: %+v", containerRegistryDiagnosticSettingName, id, err)
+	}
+
+	return nil
+}
+
+func flattenContainerRegistryDiagnosticSettings(ctx context.Context, meta interface{}, id parse.RegistryId) ([]interface{}, error) {
+	client := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+
+	targetResourceId := strings.TrimPrefix(id.ID(), "/")
+	resp, err := client.Get(ctx, targetResourceId, containerRegistryDiagnosticSettingName)
+	if err != nil {
+		// a 403 is treated the same as a 404 so that refreshing the Registry doesn't fail when the caller
+		// doesn't have permission to read Diagnostic Settings
+		if utils.ResponseWasNotFound(resp.Response) || utils.ResponseWasForbidden(resp.Response) {
+			return []interface{}{}, nil
+		}
+
+		return nil, fmt.Errorf("retrieving Diagnostic Setting %q for %s: %+v", containerRegistryDiagnosticSettingName, id, err)
+	}
+
+	props := resp.DiagnosticSettings
+	if props == nil {
+		return []interface{}{}, nil
+	}
+
+	workspaceId := ""
+	if props.WorkspaceID != nil && *props.WorkspaceID != "" {
+		parsed, err := logAnalyticsParse.LogAnalyticsWorkspaceID(*props.WorkspaceID)
+		if err != nil {
+			return nil, err
+		}
+		workspaceId = parsed.ID()
+	}
+
+	categories := make([]interface{}, 0)
+	if props.Logs != nil {
+		for _, v := range *props.Logs {
+			if v.Category == nil || v.Enabled == nil || !*v.Enabled {
+				continue
+			}
+			categories = append(categories, *v.Category)
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"log_analytics_workspace_id": workspaceId,
+			"categories":                 categories,
+		},
+	}, nil
+}
+
 func resourceContainerRegistryRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Containers.RegistriesClient
 	replicationClient := meta.(*clients.Client).Containers.ReplicationsClient
@@ -847,6 +1074,14 @@ func resourceContainerRegistryRead(d *pluginsdk.ResourceData, meta interface{})
 	d.Set("georeplication_locations", geoReplicationLocations)
 	d.Set("georeplications", geoReplications)
 
+	diagnosticSettings, err := flattenContainerRegistryDiagnosticSettings(ctx, meta, *id)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("diagnostic_settings", diagnosticSettings); err != nil {
+		return fmt.Errorf("setting `diagnostic_settings`: %+v", err)
+	}
+
 	// Deprecated as it is not returned by the API now.
 	d.Set("storage_account_id", "")
 
@@ -1040,6 +1275,11 @@ func flattenEncryption(encryptionProperty *containerregistry.EncryptionProperty)
 	if encryptionProperty == nil {
 		return nil
 	}
+	// when encryption is disabled there's no Key Vault Key or Identity to expose, and since both are Required
+	// within the block we omit it, rather than producing a block which wouldn't pass validation
+	if encryptionProperty.KeyVaultProperties == nil && !strings.EqualFold(string(encryptionProperty.Status), string(containerregistry.EncryptionStatusEnabled)) {
+		return []interface{}{}
+	}
 	encryption := make(map[string]interface{})
 	encryption["enabled"] = strings.EqualFold(string(encryptionProperty.Status), string(containerregistry.EncryptionStatusEnabled))
 	if encryptionProperty.KeyVaultProperties != nil {
@@ -339,6 +339,26 @@ func TestAccContainerRegistry_geoReplication(t *testing.T) {
 	})
 }
 
+func TestAccContainerRegistry_geoReplicationImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry", "test")
+	r := ContainerRegistryResource{}
+
+	secondaryLocation := location.Normalize(data.Locations.Secondary)
+	ternaryLocation := location.Normalize(data.Locations.Ternary)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.geoReplicationMultipleLocations(data, secondaryLocation, ternaryLocation),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("georeplications.#").HasValue("2"),
+				check.That(data.ResourceName).Key("georeplication_locations.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccContainerRegistry_geoReplicationSwitch(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_container_registry", "test")
 	r := ContainerRegistryResource{}
@@ -575,6 +595,34 @@ func TestAccContainerRegistry_geoReplicationRegionEndpoint(t *testing.T) {
 	})
 }
 
+func TestAccContainerRegistry_geoReplicationDeletionDrain(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry", "test")
+	r := ContainerRegistryResource{}
+
+	secondaryLocation := location.Normalize(data.Locations.Secondary)
+	ternaryLocation := location.Normalize(data.Locations.Ternary)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.geoReplicationDeletionDrain(data, secondaryLocation, ternaryLocation),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("georeplications.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("georeplication_deletion_drain_duration"),
+		{
+			Config: r.geoReplicationDeletionDrain(data, secondaryLocation),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("georeplications.#").HasValue("1"),
+				check.That(data.ResourceName).Key("georeplications.0.location").HasValue(secondaryLocation),
+			),
+		},
+		data.ImportStep("georeplication_deletion_drain_duration"),
+	})
+}
+
 func TestAccContainerRegistry_anonymousPull(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_container_registry", "test")
 	r := ContainerRegistryResource{}
@@ -662,6 +710,38 @@ func TestAccContainerRegistry_networkRuleBypassOption(t *testing.T) {
 	})
 }
 
+func TestAccContainerRegistry_diagnosticSettings(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry", "test")
+	r := ContainerRegistryResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.diagnosticSettings(data, `"ContainerRegistryLoginEvents"`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("diagnostic_settings.0.categories.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.diagnosticSettings(data, `"ContainerRegistryLoginEvents", "ContainerRegistryRepositoryEvents"`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("diagnostic_settings.0.categories.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.diagnosticSettingsRemoved(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("diagnostic_settings.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t ContainerRegistryResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.RegistryID(state.ID)
 	if err != nil {
@@ -862,6 +942,37 @@ resource "azurerm_container_registry" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, primaryLocation, secondaryLocation)
 }
 
+func (ContainerRegistryResource) geoReplicationDeletionDrain(data acceptance.TestData, replicationLocations ...string) string {
+	replications := ""
+	for _, replicationLocation := range replicationLocations {
+		replications += fmt.Sprintf(`
+  georeplications {
+    location = %q
+  }
+`, replicationLocation)
+	}
+
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-acr-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                                   = "testacccr%d"
+  resource_group_name                    = azurerm_resource_group.test.name
+  location                               = azurerm_resource_group.test.location
+  sku                                    = "Premium"
+  georeplication_deletion_drain_duration = "1m"
+%s
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, replications)
+}
+
 func (ContainerRegistryResource) geoReplicationUpdateWithNoLocation(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -1338,3 +1449,62 @@ resource "azurerm_container_registry" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, opt)
 }
+
+func (ContainerRegistryResource) diagnosticSettings(data acceptance.TestData, categories string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-acr-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                = "testacccr%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Basic"
+
+  diagnostic_settings {
+    log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+    categories                 = [%s]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, categories)
+}
+
+func (ContainerRegistryResource) diagnosticSettingsRemoved(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-acr-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                = "testacccr%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Basic"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
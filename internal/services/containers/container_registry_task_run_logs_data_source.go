@@ -0,0 +1,161 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// containerRegistryRunLogsDownloadTimeout is the maximum duration allowed to download the logs for a Run
+const containerRegistryRunLogsDownloadTimeout = 2 * time.Minute
+
+func dataSourceContainerRegistryTaskRunLogs() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceContainerRegistryTaskRunLogsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"run_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"container_registry_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ContainerRegistryName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"error_message": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"log_link": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"logs": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceContainerRegistryTaskRunLogsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.RunsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	containerRegistryName := d.Get("container_registry_name").(string)
+	runId := d.Get("run_id").(string)
+
+	resp, err := client.Get(ctx, resourceGroup, containerRegistryName, runId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Run %q was not found in Container Registry %q (Resource Group %q)", runId, containerRegistryName, resourceGroup)
+		}
+
+		return fmt.Errorf("retrieving Run %q (Container Registry %q, Resource Group %q): %+v", runId, containerRegistryName, resourceGroup, err)
+	}
+
+	if resp.ID == nil || *resp.ID == "" {
+		return fmt.Errorf("retrieving Run %q (Container Registry %q, Resource Group %q): `id` was nil", runId, containerRegistryName, resourceGroup)
+	}
+
+	logResp, err := client.GetLogSasURL(ctx, resourceGroup, containerRegistryName, runId)
+	if err != nil {
+		return fmt.Errorf("retrieving Log SAS URL for Run %q (Container Registry %q, Resource Group %q): %+v", runId, containerRegistryName, resourceGroup, err)
+	}
+
+	logs := ""
+	if logResp.LogLink != nil && *logResp.LogLink != "" {
+		logs, err = fetchContainerRegistryRunLogs(ctx, client.Sender, *logResp.LogLink)
+		if err != nil {
+			return fmt.Errorf("downloading logs for Run %q (Container Registry %q, Resource Group %q): %+v", runId, containerRegistryName, resourceGroup, err)
+		}
+	}
+
+	d.SetId(*resp.ID)
+	d.Set("run_id", runId)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("container_registry_name", containerRegistryName)
+
+	status := ""
+	errorMessage := ""
+	if props := resp.RunProperties; props != nil {
+		status = string(props.Status)
+		if props.RunErrorMessage != nil {
+			errorMessage = *props.RunErrorMessage
+		}
+	}
+	d.Set("status", status)
+	d.Set("error_message", errorMessage)
+	d.Set("log_link", logResp.LogLink)
+	d.Set("logs", logs)
+
+	return nil
+}
+
+// fetchContainerRegistryRunLogs downloads the contents of the log blob for a run, which is exposed
+// through a SAS link and therefore doesn't require any further authentication - so this uses the
+// Sender configured for the provider (rather than the client, which would send the bearer token)
+func fetchContainerRegistryRunLogs(ctx context.Context, sender autorest.Sender, logLink string) (string, error) {
+	if sender == nil {
+		return "", fmt.Errorf("the HTTP sender for the Runs client was nil")
+	}
+
+	// the log blob is downloaded after the Run has been retrieved, so this is bounded separately to ensure
+	// a slow download doesn't consume the remainder of the Read timeout
+	ctx, cancel := context.WithTimeout(ctx, containerRegistryRunLogsDownloadTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, logLink, nil)
+	if err != nil {
+		return "", fmt.Errorf("building request: %+v", err)
+	}
+
+	resp, err := sender.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("sending request: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("reading response body: %+v", err)
+	}
+
+	return string(body), nil
+}
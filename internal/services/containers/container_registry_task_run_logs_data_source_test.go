@@ -0,0 +1,47 @@
+package containers_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ContainerRegistryTaskRunLogsDataSource struct {
+}
+
+func TestAccDataSourceContainerRegistryTaskRunLogs_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_CONTAINER_REGISTRY_NAME") == "" || os.Getenv("ARM_TEST_CONTAINER_REGISTRY_RUN_ID") == "" || os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP") == "" {
+		t.Skip("Skipping as ARM_TEST_CONTAINER_REGISTRY_NAME, ARM_TEST_CONTAINER_REGISTRY_RUN_ID or ARM_TEST_DATA_RESOURCE_GROUP is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azurerm_container_registry_task_run_logs", "test")
+	r := ContainerRegistryTaskRunLogsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("status").Exists(),
+				check.That(data.ResourceName).Key("log_link").Exists(),
+				check.That(data.ResourceName).Key("logs").Exists(),
+			),
+		},
+	})
+}
+
+func (ContainerRegistryTaskRunLogsDataSource) basic() string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_container_registry_task_run_logs" "test" {
+  run_id                  = %q
+  container_registry_name = %q
+  resource_group_name     = %q
+}
+`, os.Getenv("ARM_TEST_CONTAINER_REGISTRY_RUN_ID"), os.Getenv("ARM_TEST_CONTAINER_REGISTRY_NAME"), os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP"))
+}
@@ -0,0 +1,71 @@
+package containers
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/containerregistry/mgmt/2020-11-01-preview/containerregistry"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestContainerRegistryReplicationRequiresDeletion(t *testing.T) {
+	testData := []struct {
+		name     string
+		existing containerregistry.Replication
+		expected bool
+	}{
+		{
+			name:     "unchanged",
+			existing: testContainerRegistryReplication("westeurope", containerregistry.ZoneRedundancyDisabled, true),
+			expected: false,
+		},
+		{
+			name:     "location casing",
+			existing: testContainerRegistryReplication("West Europe", containerregistry.ZoneRedundancyDisabled, true),
+			expected: false,
+		},
+		{
+			name:     "regional endpoint changed",
+			existing: testContainerRegistryReplication("westeurope", containerregistry.ZoneRedundancyDisabled, false),
+			expected: false,
+		},
+		{
+			name:     "zone redundancy unset",
+			existing: testContainerRegistryReplication("westeurope", "", true),
+			expected: false,
+		},
+		{
+			name:     "zone redundancy changed",
+			existing: testContainerRegistryReplication("westeurope", containerregistry.ZoneRedundancyEnabled, true),
+			expected: true,
+		},
+		{
+			name:     "removed",
+			existing: testContainerRegistryReplication("northeurope", containerregistry.ZoneRedundancyDisabled, true),
+			expected: true,
+		},
+	}
+
+	newGeoReplications := []containerregistry.Replication{
+		testContainerRegistryReplication("westeurope", containerregistry.ZoneRedundancyDisabled, true),
+		testContainerRegistryReplication("eastus", containerregistry.ZoneRedundancyEnabled, true),
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		actual := containerRegistryReplicationRequiresDeletion(v.existing, newGeoReplications)
+		if actual != v.expected {
+			t.Fatalf("expected %t but got %t", v.expected, actual)
+		}
+	}
+}
+
+func testContainerRegistryReplication(location string, zoneRedundancy containerregistry.ZoneRedundancy, regionEndpointEnabled bool) containerregistry.Replication {
+	return containerregistry.Replication{
+		Location: utils.String(location),
+		ReplicationProperties: &containerregistry.ReplicationProperties{
+			ZoneRedundancy:        zoneRedundancy,
+			RegionEndpointEnabled: utils.Bool(regionEndpointEnabled),
+		},
+	}
+}
@@ -45,6 +45,22 @@ type configAzureAD struct {
 	APIServerID string `yaml:"apiserver-id,omitempty"`
 	ClientID    string `yaml:"client-id,omitempty"`
 	TenantID    string `yaml:"tenant-id,omitempty"`
+	Environment string `yaml:"environment,omitempty"`
+}
+
+type userItemExec struct {
+	Name string   `yaml:"name"`
+	User userExec `yaml:"user"`
+}
+
+type userExec struct {
+	Exec execConfig `yaml:"exec"`
+}
+
+type execConfig struct {
+	APIVersion string   `yaml:"apiVersion"`
+	Command    string   `yaml:"command"`
+	Args       []string `yaml:"args"`
 }
 
 type contextItem struct {
@@ -77,6 +93,19 @@ type KubeConfigAAD struct {
 	Users          []userItemAAD `yaml:"users"`
 }
 
+type KubeConfigExec struct {
+	KubeConfigBase `yaml:",inline"`
+	Users          []userItemExec `yaml:"users"`
+}
+
+const (
+	// KubeConfigExecAPIVersion is the version of the client authentication API used by the exec credential plugin
+	KubeConfigExecAPIVersion = "client.authentication.k8s.io/v1beta1"
+
+	// KubeConfigExecCommand is the exec credential plugin used to authenticate against Azure Active Directory
+	KubeConfigExecCommand = "kubelogin"
+)
+
 func ParseKubeConfig(config string) (*KubeConfig, error) {
 	if config == "" {
 		return nil, fmt.Errorf("Cannot parse empty config")
@@ -122,3 +151,53 @@ func ParseKubeConfigAAD(config string) (*KubeConfigAAD, error) {
 
 	return &kubeConfig, nil
 }
+
+// ConvertKubeConfigAADToExec converts a kubeconfig using the (deprecated) `azure` auth-provider into an
+// equivalent kubeconfig using the `kubelogin` exec credential plugin, matching `kubelogin convert-kubeconfig`.
+// The login method is intentionally omitted so that it can be specified using the `AAD_LOGIN_METHOD`
+// environment variable (defaulting to `devicecode`)
+func ConvertKubeConfigAADToExec(config KubeConfigAAD) KubeConfigExec {
+	users := make([]userItemExec, 0, len(config.Users))
+	for _, item := range config.Users {
+		azureAD := item.User.AuthProvider.Config
+
+		args := []string{"get-token"}
+		if azureAD.Environment != "" {
+			args = append(args, "--environment", azureAD.Environment)
+		}
+		if azureAD.APIServerID != "" {
+			args = append(args, "--server-id", azureAD.APIServerID)
+		}
+		if azureAD.ClientID != "" {
+			args = append(args, "--client-id", azureAD.ClientID)
+		}
+		if azureAD.TenantID != "" {
+			args = append(args, "--tenant-id", azureAD.TenantID)
+		}
+
+		users = append(users, userItemExec{
+			Name: item.Name,
+			User: userExec{
+				Exec: execConfig{
+					APIVersion: KubeConfigExecAPIVersion,
+					Command:    KubeConfigExecCommand,
+					Args:       args,
+				},
+			},
+		})
+	}
+
+	return KubeConfigExec{
+		KubeConfigBase: config.KubeConfigBase,
+		Users:          users,
+	}
+}
+
+func MarshalKubeConfigExec(config KubeConfigExec) (string, error) {
+	out, err := yaml.Marshal(config)
+	if err != nil {
+		return "", fmt.Errorf("Failed to marshal YAML config with error %+v", err)
+	}
+
+	return string(out), nil
+}
@@ -6,6 +6,8 @@ import (
 	"path/filepath"
 	"reflect"
 	"testing"
+
+	"gopkg.in/yaml.v2"
 )
 
 func TestParseKubeConfig(t *testing.T) {
@@ -192,3 +194,51 @@ func LoadConfig(fileName string) string {
 
 	return string(bytes)
 }
+
+func TestConvertKubeConfigAADToExec(t *testing.T) {
+	aadConfig, err := ParseKubeConfigAAD(LoadConfig("user_with_aad.yml"))
+	if err != nil {
+		t.Fatalf("parsing AAD config: %+v", err)
+	}
+
+	result := ConvertKubeConfigAADToExec(*aadConfig)
+
+	if !reflect.DeepEqual(aadConfig.KubeConfigBase, result.KubeConfigBase) {
+		t.Fatalf("expected the clusters and contexts to be retained but got '%+v'", result.KubeConfigBase)
+	}
+
+	expected := []userItemExec{
+		{
+			Name: "test-user",
+			User: userExec{
+				Exec: execConfig{
+					APIVersion: KubeConfigExecAPIVersion,
+					Command:    KubeConfigExecCommand,
+					Args: []string{
+						"get-token",
+						"--environment", "AzurePublicCloud",
+						"--server-id", "test-apiserver-id",
+						"--client-id", "test-client-id",
+						"--tenant-id", "test-tenant-id",
+					},
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(expected, result.Users) {
+		t.Fatalf("expected users '%+v' but got '%+v'", expected, result.Users)
+	}
+
+	raw, err := MarshalKubeConfigExec(result)
+	if err != nil {
+		t.Fatalf("marshalling exec config: %+v", err)
+	}
+
+	var roundTripped KubeConfigExec
+	if err := yaml.Unmarshal([]byte(raw), &roundTripped); err != nil {
+		t.Fatalf("unmarshalling exec config: %+v", err)
+	}
+	if !reflect.DeepEqual(result.Users, roundTripped.Users) {
+		t.Fatalf("expected users '%+v' after round-trip but got '%+v'", result.Users, roundTripped.Users)
+	}
+}
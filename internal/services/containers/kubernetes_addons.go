@@ -2,6 +2,7 @@ package containers
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-08-01/containerservice"
@@ -18,13 +19,14 @@ import (
 
 const (
 	// note: the casing on these keys is important
-	aciConnectorKey              = "aciConnectorLinux"
-	azurePolicyKey               = "azurepolicy"
-	kubernetesDashboardKey       = "kubeDashboard"
-	httpApplicationRoutingKey    = "httpApplicationRouting"
-	omsAgentKey                  = "omsagent"
-	ingressApplicationGatewayKey = "ingressApplicationGateway"
-	openServiceMeshKey           = "openServiceMesh"
+	aciConnectorKey                 = "aciConnectorLinux"
+	azurePolicyKey                  = "azurepolicy"
+	kubernetesDashboardKey          = "kubeDashboard"
+	httpApplicationRoutingKey       = "httpApplicationRouting"
+	omsAgentKey                     = "omsagent"
+	ingressApplicationGatewayKey    = "ingressApplicationGateway"
+	openServiceMeshKey              = "openServiceMesh"
+	azureKeyvaultSecretsProviderKey = "azureKeyvaultSecretsProvider"
 )
 
 // The AKS API hard-codes which add-ons are supported in which environment
@@ -235,6 +237,51 @@ func schemaKubernetesAddOnProfiles() *pluginsdk.Schema {
 						},
 					},
 				},
+
+				"azure_keyvault_secrets_provider": {
+					Type:     pluginsdk.TypeList,
+					MaxItems: 1,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"enabled": {
+								Type:     pluginsdk.TypeBool,
+								Required: true,
+							},
+							"secret_rotation_enabled": {
+								Type:     pluginsdk.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+							"secret_rotation_interval": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								Default:      "2m",
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							"secret_identity": {
+								Type:     pluginsdk.TypeList,
+								Computed: true,
+								Elem: &pluginsdk.Resource{
+									Schema: map[string]*pluginsdk.Schema{
+										"client_id": {
+											Type:     pluginsdk.TypeString,
+											Computed: true,
+										},
+										"object_id": {
+											Type:     pluginsdk.TypeString,
+											Computed: true,
+										},
+										"user_assigned_identity_id": {
+											Type:     pluginsdk.TypeString,
+											Computed: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 		},
 	}
@@ -246,13 +293,14 @@ func expandKubernetesAddOnProfiles(input []interface{}, env azure.Environment) (
 	}
 
 	profiles := map[string]*containerservice.ManagedClusterAddonProfile{
-		aciConnectorKey:              &disabled,
-		azurePolicyKey:               &disabled,
-		kubernetesDashboardKey:       &disabled,
-		httpApplicationRoutingKey:    &disabled,
-		omsAgentKey:                  &disabled,
-		ingressApplicationGatewayKey: &disabled,
-		openServiceMeshKey:           &disabled,
+		aciConnectorKey:                 &disabled,
+		azurePolicyKey:                  &disabled,
+		kubernetesDashboardKey:          &disabled,
+		httpApplicationRoutingKey:       &disabled,
+		omsAgentKey:                     &disabled,
+		ingressApplicationGatewayKey:    &disabled,
+		openServiceMeshKey:              &disabled,
+		azureKeyvaultSecretsProviderKey: &disabled,
 	}
 
 	if len(input) == 0 || input[0] == nil {
@@ -371,6 +419,20 @@ func expandKubernetesAddOnProfiles(input []interface{}, env azure.Environment) (
 
 	}
 
+	azureKeyvaultSecretsProvider := profile["azure_keyvault_secrets_provider"].([]interface{})
+	if len(azureKeyvaultSecretsProvider) > 0 && azureKeyvaultSecretsProvider[0] != nil {
+		value := azureKeyvaultSecretsProvider[0].(map[string]interface{})
+		enabled := value["enabled"].(bool)
+
+		addonProfiles[azureKeyvaultSecretsProviderKey] = &containerservice.ManagedClusterAddonProfile{
+			Enabled: utils.Bool(enabled),
+			Config: map[string]*string{
+				"enableSecretRotation": utils.String(strconv.FormatBool(value["secret_rotation_enabled"].(bool))),
+				"rotationPollInterval": utils.String(value["secret_rotation_interval"].(string)),
+			},
+		}
+	}
+
 	return filterUnsupportedKubernetesAddOns(addonProfiles, env)
 }
 
@@ -544,20 +606,48 @@ func flattenKubernetesAddOnProfiles(profile map[string]*containerservice.Managed
 		})
 	}
 
+	azureKeyvaultSecretsProviders := make([]interface{}, 0)
+	if azureKeyvaultSecretsProvider := kubernetesAddonProfileLocate(profile, azureKeyvaultSecretsProviderKey); azureKeyvaultSecretsProvider != nil {
+		enabled := false
+		if enabledVal := azureKeyvaultSecretsProvider.Enabled; enabledVal != nil {
+			enabled = *enabledVal
+		}
+
+		secretRotationEnabled := false
+		if v := kubernetesAddonProfilelocateInConfig(azureKeyvaultSecretsProvider.Config, "enableSecretRotation"); v != nil {
+			secretRotationEnabled = strings.EqualFold(*v, "true")
+		}
+
+		secretRotationInterval := ""
+		if v := kubernetesAddonProfilelocateInConfig(azureKeyvaultSecretsProvider.Config, "rotationPollInterval"); v != nil {
+			secretRotationInterval = *v
+		}
+
+		secretIdentity := flattenKubernetesClusterAddOnIdentityProfile(azureKeyvaultSecretsProvider.Identity)
+
+		azureKeyvaultSecretsProviders = append(azureKeyvaultSecretsProviders, map[string]interface{}{
+			"enabled":                  enabled,
+			"secret_rotation_enabled":  secretRotationEnabled,
+			"secret_rotation_interval": secretRotationInterval,
+			"secret_identity":          secretIdentity,
+		})
+	}
+
 	// this is a UX hack, since if the top level block isn't defined everything should be turned off
-	if len(aciConnectors) == 0 && len(azurePolicies) == 0 && len(httpApplicationRoutes) == 0 && len(kubeDashboards) == 0 && len(omsAgents) == 0 && len(ingressApplicationGateways) == 0 && len(openServiceMeshes) == 0 {
+	if len(aciConnectors) == 0 && len(azurePolicies) == 0 && len(httpApplicationRoutes) == 0 && len(kubeDashboards) == 0 && len(omsAgents) == 0 && len(ingressApplicationGateways) == 0 && len(openServiceMeshes) == 0 && len(azureKeyvaultSecretsProviders) == 0 {
 		return []interface{}{}
 	}
 
 	return []interface{}{
 		map[string]interface{}{
-			"aci_connector_linux":         aciConnectors,
-			"azure_policy":                azurePolicies,
-			"http_application_routing":    httpApplicationRoutes,
-			"kube_dashboard":              kubeDashboards,
-			"oms_agent":                   omsAgents,
-			"ingress_application_gateway": ingressApplicationGateways,
-			"open_service_mesh":           openServiceMeshes,
+			"aci_connector_linux":             aciConnectors,
+			"azure_policy":                    azurePolicies,
+			"http_application_routing":        httpApplicationRoutes,
+			"kube_dashboard":                  kubeDashboards,
+			"oms_agent":                       omsAgents,
+			"ingress_application_gateway":     ingressApplicationGateways,
+			"open_service_mesh":               openServiceMeshes,
+			"azure_keyvault_secrets_provider": azureKeyvaultSecretsProviders,
 		},
 	}
 }
@@ -9,17 +9,18 @@ import (
 )
 
 var kubernetesAddOnTests = map[string]func(t *testing.T){
-	"addonProfileAciConnectorLinux":         testAccKubernetesCluster_addonProfileAciConnectorLinux,
-	"addonProfileAciConnectorLinuxDisabled": testAccKubernetesCluster_addonProfileAciConnectorLinuxDisabled,
-	"addonProfileAzurePolicy":               testAccKubernetesCluster_addonProfileAzurePolicy,
-	"addonProfileKubeDashboard":             testAccKubernetesCluster_addonProfileKubeDashboard,
-	"addonProfileOMS":                       testAccKubernetesCluster_addonProfileOMS,
-	"addonProfileOMSToggle":                 testAccKubernetesCluster_addonProfileOMSToggle,
-	"addonProfileRouting":                   testAccKubernetesCluster_addonProfileRoutingToggle,
-	"addonProfileAppGatewayAppGatewayId":    testAccKubernetesCluster_addonProfileIngressApplicationGateway_appGatewayId,
-	"addonProfileAppGatewaySubnetCIDR":      testAccKubernetesCluster_addonProfileIngressApplicationGateway_subnetCIDR,
-	"addonProfileAppGatewaySubnetID":        testAccKubernetesCluster_addonProfileIngressApplicationGateway_subnetId,
-	"addonProfileOpenServiceMesh":           testAccKubernetesCluster_addonProfileOpenServiceMesh,
+	"addonProfileAciConnectorLinux":            testAccKubernetesCluster_addonProfileAciConnectorLinux,
+	"addonProfileAciConnectorLinuxDisabled":    testAccKubernetesCluster_addonProfileAciConnectorLinuxDisabled,
+	"addonProfileAzurePolicy":                  testAccKubernetesCluster_addonProfileAzurePolicy,
+	"addonProfileKubeDashboard":                testAccKubernetesCluster_addonProfileKubeDashboard,
+	"addonProfileOMS":                          testAccKubernetesCluster_addonProfileOMS,
+	"addonProfileOMSToggle":                    testAccKubernetesCluster_addonProfileOMSToggle,
+	"addonProfileRouting":                      testAccKubernetesCluster_addonProfileRoutingToggle,
+	"addonProfileAppGatewayAppGatewayId":       testAccKubernetesCluster_addonProfileIngressApplicationGateway_appGatewayId,
+	"addonProfileAppGatewaySubnetCIDR":         testAccKubernetesCluster_addonProfileIngressApplicationGateway_subnetCIDR,
+	"addonProfileAppGatewaySubnetID":           testAccKubernetesCluster_addonProfileIngressApplicationGateway_subnetId,
+	"addonProfileOpenServiceMesh":              testAccKubernetesCluster_addonProfileOpenServiceMesh,
+	"addonProfileAzureKeyvaultSecretsProvider": testAccKubernetesCluster_addonProfileAzureKeyvaultSecretsProvider,
 }
 
 var addOnAppGatewaySubnetCIDR string = "10.241.0.0/16" // AKS will use 10.240.0.0/16 for the aks subnet so use 10.241.0.0/16 for the app gateway subnet
@@ -375,6 +376,40 @@ func testAccKubernetesCluster_addonProfileOpenServiceMesh(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesCluster_addonProfileAzureKeyvaultSecretsProvider(t *testing.T) {
+	checkIfShouldRunTestsIndividually(t)
+	testAccKubernetesCluster_addonProfileAzureKeyvaultSecretsProvider(t)
+}
+
+func testAccKubernetesCluster_addonProfileAzureKeyvaultSecretsProvider(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster", "test")
+	r := KubernetesClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.addonProfileAzureKeyvaultSecretsProviderConfig(data, false, "2m"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("addon_profile.0.azure_keyvault_secrets_provider.#").HasValue("1"),
+				check.That(data.ResourceName).Key("addon_profile.0.azure_keyvault_secrets_provider.0.enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("addon_profile.0.azure_keyvault_secrets_provider.0.secret_rotation_enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("addon_profile.0.azure_keyvault_secrets_provider.0.secret_rotation_interval").HasValue("2m"),
+				check.That(data.ResourceName).Key("addon_profile.0.azure_keyvault_secrets_provider.0.secret_identity.0.client_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.addonProfileAzureKeyvaultSecretsProviderConfig(data, true, "5m"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("addon_profile.0.azure_keyvault_secrets_provider.0.secret_rotation_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("addon_profile.0.azure_keyvault_secrets_provider.0.secret_rotation_interval").HasValue("5m"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (KubernetesClusterResource) addonProfileAciConnectorLinuxConfig(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -1173,3 +1208,41 @@ resource "azurerm_kubernetes_cluster" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, enabled)
 }
+
+func (KubernetesClusterResource) addonProfileAzureKeyvaultSecretsProviderConfig(data acceptance.TestData, secretRotation bool, rotationInterval string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%d"
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+  }
+
+  addon_profile {
+    azure_keyvault_secrets_provider {
+      enabled                  = true
+      secret_rotation_enabled  = %t
+      secret_rotation_interval = "%s"
+    }
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, secretRotation, rotationInterval)
+}
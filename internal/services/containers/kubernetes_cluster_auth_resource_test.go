@@ -21,6 +21,7 @@ var kubernetesAuthTests = map[string]func(t *testing.T){
 	"AADManagedLocalAccountDisabled":       testAccKubernetesCluster_roleBasedAccessControlAADManagedWithLocalAccountDisabled,
 	"AADManagedLocalAccountDisabledUpdate": testAccKubernetesCluster_roleBasedAccessControlAADManagedWithLocalAccountDisabledUpdated,
 	"AADManagedChange":                     testAccKubernetesCluster_roleBasedAccessControlAADManagedChange,
+	"runCommandDisabled":                   testAccKubernetesCluster_runCommandDisabled,
 	"roleBasedAccessControlAzure":          testAccKubernetesCluster_roleBasedAccessControlAzure,
 	"servicePrincipal":                     testAccKubernetesCluster_servicePrincipal,
 	"servicePrincipalToSystemAssigned":     testAccKubernetesCluster_servicePrincipalToSystemAssignedIdentity,
@@ -385,6 +386,35 @@ func testAccKubernetesCluster_roleBasedAccessControlAADManagedWithLocalAccountDi
 	})
 }
 
+func TestAccKubernetesCluster_runCommandDisabled(t *testing.T) {
+	checkIfShouldRunTestsIndividually(t)
+	testAccKubernetesCluster_runCommandDisabled(t)
+}
+
+func testAccKubernetesCluster_runCommandDisabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster", "test")
+	r := KubernetesClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.runCommandConfig(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("run_command_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.runCommandConfig(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("run_command_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccKubernetesCluster_roleBasedAccessControlAADManagedWithLocalAccountDisabledUpdated(t *testing.T) {
 	checkIfShouldRunTestsIndividually(t)
 	testAccKubernetesCluster_roleBasedAccessControlAADManagedWithLocalAccountDisabledUpdated(t)
@@ -1278,3 +1308,34 @@ resource "azurerm_kubernetes_cluster" "test" {
 }
 `, tenantId, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, altClientId, altClientSecret, altClientId)
 }
+
+func (KubernetesClusterResource) runCommandConfig(data acceptance.TestData, runCommandEnabled bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%d"
+  run_command_enabled = %t
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, runCommandEnabled)
+}
@@ -0,0 +1,194 @@
+package containers
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-08-01/containerservice"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceKubernetesClusterCommandInvocation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceKubernetesClusterCommandInvocationCreate,
+		Read:   resourceKubernetesClusterCommandInvocationRead,
+		Delete: resourceKubernetesClusterCommandInvocationDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"kubernetes_cluster_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: containerValidate.ClusterID,
+			},
+
+			"command": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"context_files": {
+				Type:      pluginsdk.TypeMap,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"exit_code": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"logs": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceKubernetesClusterCommandInvocationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.KubernetesClustersClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	clusterId, err := parse.ClusterID(d.Get("kubernetes_cluster_id").(string))
+	if err != nil {
+		return err
+	}
+
+	request := containerservice.RunCommandRequest{
+		Command: utils.String(d.Get("command").(string)),
+	}
+
+	if files := d.Get("context_files").(map[string]interface{}); len(files) > 0 {
+		commandContext, err := expandKubernetesClusterCommandInvocationContext(files)
+		if err != nil {
+			return fmt.Errorf("building `context_files`: %+v", err)
+		}
+		request.Context = commandContext
+	}
+
+	future, err := client.RunCommand(ctx, clusterId.ResourceGroup, clusterId.ManagedClusterName, request)
+	if err != nil {
+		return fmt.Errorf("running command on %s: %+v", *clusterId, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for command to complete on %s: %+v", *clusterId, err)
+	}
+
+	result, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving result of command on %s: %+v", *clusterId, err)
+	}
+
+	if result.ID == nil || *result.ID == "" {
+		return fmt.Errorf("retrieving result of command on %s: `id` was nil", *clusterId)
+	}
+
+	id := parse.NewClusterCommandResultID(clusterId.SubscriptionId, clusterId.ResourceGroup, clusterId.ManagedClusterName, *result.ID)
+
+	exitCode := 0
+	logs := ""
+	if props := result.CommandResultProperties; props != nil {
+		if props.Reason != nil && *props.Reason != "" {
+			return fmt.Errorf("running command on %s: %s", *clusterId, *props.Reason)
+		}
+		if props.ExitCode != nil {
+			exitCode = int(*props.ExitCode)
+		}
+		if props.Logs != nil {
+			logs = *props.Logs
+		}
+	}
+
+	d.SetId(id.ID())
+	d.Set("exit_code", exitCode)
+	d.Set("logs", logs)
+
+	return resourceKubernetesClusterCommandInvocationRead(d, meta)
+}
+
+func resourceKubernetesClusterCommandInvocationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.KubernetesClustersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ClusterCommandResultID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// command results are only retained by the API for a short period of time, so the values
+	// captured during Create are kept in the state - we only need to check the cluster still exists
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ManagedClusterName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Kubernetes Cluster %q for %s was not found - removing from state!", id.ManagedClusterName, *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Kubernetes Cluster for %s: %+v", *id, err)
+	}
+
+	d.Set("kubernetes_cluster_id", parse.NewClusterID(id.SubscriptionId, id.ResourceGroup, id.ManagedClusterName).ID())
+
+	return nil
+}
+
+func resourceKubernetesClusterCommandInvocationDelete(d *pluginsdk.ResourceData, _ interface{}) error {
+	// a command which has been run can't be undone, so this only removes the Command Result from the state
+	return nil
+}
+
+// expandKubernetesClusterCommandInvocationContext builds the base64 encoded zip file containing the
+// files made available to the command, which is the format the RunCommand API expects
+func expandKubernetesClusterCommandInvocationContext(input map[string]interface{}) (*string, error) {
+	fileNames := make([]string, 0, len(input))
+	for name := range input {
+		fileNames = append(fileNames, name)
+	}
+	sort.Strings(fileNames)
+
+	buf := new(bytes.Buffer)
+	writer := zip.NewWriter(buf)
+	for _, name := range fileNames {
+		file, err := writer.Create(name)
+		if err != nil {
+			return nil, fmt.Errorf("adding %q: %+v", name, err)
+		}
+		if _, err := file.Write([]byte(input[name].(string))); err != nil {
+			return nil, fmt.Errorf("writing %q: %+v", name, err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("closing zip file: %+v", err)
+	}
+
+	return utils.String(base64.StdEncoding.EncodeToString(buf.Bytes())), nil
+}
@@ -0,0 +1,73 @@
+package containers_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type KubernetesClusterCommandInvocationResource struct {
+}
+
+func TestAccKubernetesClusterCommandInvocation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_command_invocation", "test")
+	r := KubernetesClusterCommandInvocationResource{}
+
+	// a command can't be un-run, so there's nothing to check once it's been destroyed
+	data.ResourceTestSkipCheckDestroyed(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("exit_code").HasValue("0"),
+				check.That(data.ResourceName).Key("logs").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccKubernetesClusterCommandInvocation_contextFiles(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_command_invocation", "test")
+	r := KubernetesClusterCommandInvocationResource{}
+
+	data.ResourceTestSkipCheckDestroyed(t, []acceptance.TestStep{
+		{
+			Config: r.contextFiles(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("exit_code").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (KubernetesClusterCommandInvocationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_command_invocation" "test" {
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+  command               = "kubectl get nodes"
+}
+`, KubernetesClusterResource{}.basicVMSSConfig(data))
+}
+
+func (KubernetesClusterCommandInvocationResource) contextFiles(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_command_invocation" "test" {
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+  command               = "kubectl apply -f namespace.yaml"
+
+  context_files = {
+    "namespace.yaml" = <<YAML
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: acctest%d
+YAML
+  }
+}
+`, KubernetesClusterResource{}.basicVMSSConfig(data), data.RandomInteger)
+}
@@ -488,6 +488,32 @@ func testAccKubernetesCluster_privateClusterOnWithPrivateDNSZoneSystem(t *testin
 	})
 }
 
+func TestAccKubernetesCluster_privateClusterOnWithPrivateDNSZoneNone(t *testing.T) {
+	checkIfShouldRunTestsIndividually(t)
+	testAccKubernetesCluster_privateClusterOnWithPrivateDNSZoneNone(t)
+}
+
+func testAccKubernetesCluster_privateClusterOnWithPrivateDNSZoneNone(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster", "test")
+	r := KubernetesClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.privateClusterWithPrivateDNSZoneNoneConfig(data, false),
+			ExpectError: regexp.MustCompile("`private_cluster_public_fqdn_enabled` must be set to `true` when `private_dns_zone_id` is set to `None`"),
+		},
+		{
+			Config: r.privateClusterWithPrivateDNSZoneNoneConfig(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("private_cluster_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("private_dns_zone_id").HasValue("None"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccKubernetesCluster_privateClusterOff(t *testing.T) {
 	checkIfShouldRunTestsIndividually(t)
 	testAccKubernetesCluster_privateClusterOff(t)
@@ -1657,6 +1683,44 @@ resource "azurerm_kubernetes_cluster" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, enablePrivateCluster, data.RandomInteger)
 }
 
+func (KubernetesClusterResource) privateClusterWithPrivateDNSZoneNoneConfig(data acceptance.TestData, publicFQDNEnabled bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                                = "acctestaks%d"
+  location                            = azurerm_resource_group.test.location
+  resource_group_name                 = azurerm_resource_group.test.name
+  dns_prefix                          = "acctestaks%d"
+  private_cluster_enabled             = true
+  private_cluster_public_fqdn_enabled = %t
+  private_dns_zone_id                 = "None"
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  network_profile {
+    network_plugin    = "kubenet"
+    load_balancer_sku = "standard"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, publicFQDNEnabled)
+}
+
 func (KubernetesClusterResource) standardLoadBalancerConfig(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
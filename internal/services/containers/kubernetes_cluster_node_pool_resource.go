@@ -1,6 +1,7 @@
 package containers
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -46,6 +47,10 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 			0: migration.KubernetesClusterNodePoolV0ToV1{},
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+			return validateNodePoolSwapFileSize(diff.Get("kubelet_config").([]interface{}), diff.Get("linux_os_config").([]interface{}))
+		}),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -166,7 +171,6 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 			"node_labels": {
 				Type:     pluginsdk.TypeMap,
 				Optional: true,
-				ForceNew: true,
 				Computed: true,
 				Elem: &pluginsdk.Schema{
 					Type: pluginsdk.TypeString,
@@ -185,7 +189,6 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 			"node_taints": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
-				ForceNew: true,
 				Elem: &pluginsdk.Schema{
 					Type: pluginsdk.TypeString,
 				},
@@ -264,6 +267,23 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 				ValidateFunc: computeValidate.ProximityPlacementGroupID,
 			},
 
+			"scale_down_mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(containerservice.ScaleDownModeDelete),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(containerservice.ScaleDownModeDeallocate),
+					string(containerservice.ScaleDownModeDelete),
+				}, false),
+			},
+
+			"snapshot_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: containerValidate.NodePoolSnapshotID,
+			},
+
 			"spot_max_price": {
 				Type:         pluginsdk.TypeFloat,
 				Optional:     true,
@@ -363,6 +383,7 @@ func resourceKubernetesClusterNodePoolCreate(d *pluginsdk.ResourceData, meta int
 		EnableNodePublicIP:     utils.Bool(d.Get("enable_node_public_ip").(bool)),
 		KubeletDiskType:        containerservice.KubeletDiskType(d.Get("kubelet_disk_type").(string)),
 		Mode:                   mode,
+		ScaleDownMode:          containerservice.ScaleDownMode(d.Get("scale_down_mode").(string)),
 		ScaleSetPriority:       containerservice.ScaleSetPriority(priority),
 		Tags:                   tags.Expand(t),
 		Type:                   containerservice.AgentPoolTypeVirtualMachineScaleSets,
@@ -444,6 +465,12 @@ func resourceKubernetesClusterNodePoolCreate(d *pluginsdk.ResourceData, meta int
 		profile.VnetSubnetID = utils.String(vnetSubnetID)
 	}
 
+	if snapshotId := d.Get("snapshot_id").(string); snapshotId != "" {
+		profile.CreationData = &containerservice.CreationData{
+			SourceResourceID: utils.String(snapshotId),
+		}
+	}
+
 	maxCount := d.Get("max_count").(int)
 	minCount := d.Get("min_count").(int)
 
@@ -579,6 +606,14 @@ func resourceKubernetesClusterNodePoolUpdate(d *pluginsdk.ResourceData, meta int
 		props.Count = utils.Int32(int32(d.Get("node_count").(int)))
 	}
 
+	if d.HasChange("node_labels") {
+		props.NodeLabels = utils.ExpandMapStringPtrString(d.Get("node_labels").(map[string]interface{}))
+	}
+
+	if d.HasChange("node_taints") {
+		props.NodeTaints = utils.ExpandStringSlice(d.Get("node_taints").([]interface{}))
+	}
+
 	if d.HasChange("node_public_ip_prefix_id") {
 		props.NodePublicIPPrefixID = utils.String(d.Get("node_public_ip_prefix_id").(string))
 	}
@@ -602,6 +637,10 @@ func resourceKubernetesClusterNodePoolUpdate(d *pluginsdk.ResourceData, meta int
 		props.OrchestratorVersion = utils.String(orchestratorVersion)
 	}
 
+	if d.HasChange("scale_down_mode") {
+		props.ScaleDownMode = containerservice.ScaleDownMode(d.Get("scale_down_mode").(string))
+	}
+
 	if d.HasChange("tags") {
 		t := d.Get("tags").(map[string]interface{})
 		props.Tags = tags.Expand(t)
@@ -789,6 +828,22 @@ func resourceKubernetesClusterNodePoolRead(d *pluginsdk.ResourceData, meta inter
 
 		d.Set("proximity_placement_group_id", props.ProximityPlacementGroupID)
 
+		scaleDownMode := string(containerservice.ScaleDownModeDelete)
+		if props.ScaleDownMode != "" {
+			scaleDownMode = string(props.ScaleDownMode)
+		}
+		d.Set("scale_down_mode", scaleDownMode)
+
+		snapshotId := ""
+		if props.CreationData != nil && props.CreationData.SourceResourceID != nil {
+			id, err := parse.NodePoolSnapshotIDInsensitively(*props.CreationData.SourceResourceID)
+			if err != nil {
+				return fmt.Errorf("parsing %q: %+v", *props.CreationData.SourceResourceID, err)
+			}
+			snapshotId = id.ID()
+		}
+		d.Set("snapshot_id", snapshotId)
+
 		spotMaxPrice := -1.0
 		if props.SpotMaxPrice != nil {
 			spotMaxPrice = *props.SpotMaxPrice
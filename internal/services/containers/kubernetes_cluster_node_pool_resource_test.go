@@ -549,11 +549,24 @@ func testAccKubernetesClusterNodePool_nodeTaints(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_node_pool", "test")
 	r := KubernetesClusterNodePoolResource{}
 
+	// the ID of the Node Pool is derived from its name, so the unique ID of the underlying
+	// Virtual Machine Scale Set is used to confirm the Node Pool wasn't recreated
+	scaleSetUniqueId := ""
 	data.ResourceTest(t, r, []acceptance.TestStep{
 		{
-			Config: r.nodeTaintsConfig(data),
+			Config: r.nodeTaintsConfig(data, "key=value:NoSchedule"),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.scaleSetUniqueIdUnchanged(&scaleSetUniqueId)),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.nodeTaintsConfig(data, "key2=value2:NoExecute"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("node_taints.0").HasValue("key2=value2:NoExecute"),
+				data.CheckWithClient(r.scaleSetUniqueIdUnchanged(&scaleSetUniqueId)),
 			),
 		},
 		data.ImportStep(),
@@ -962,6 +975,46 @@ func TestAccKubernetesClusterNodePool_turnOnEnableAutoScalingWithDefaultMaxMinCo
 	})
 }
 
+func TestAccKubernetesClusterNodePool_snapshotId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_node_pool", "test")
+	r := KubernetesClusterNodePoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.snapshotId(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("snapshot_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesClusterNodePool_scaleDownMode(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_node_pool", "test")
+	r := KubernetesClusterNodePoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.scaleDownMode(data, "Delete"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("scale_down_mode").HasValue("Delete"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.scaleDownMode(data, "Deallocate"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("scale_down_mode").HasValue("Deallocate"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t KubernetesClusterNodePoolResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.NodePoolID(state.ID)
 	if err != nil {
@@ -976,6 +1029,59 @@ func (t KubernetesClusterNodePoolResource) Exists(ctx context.Context, clients *
 	return utils.Bool(resp.ID != nil), nil
 }
 
+// scaleSetUniqueIdUnchanged records the unique ID of the Virtual Machine Scale Set backing the Node Pool
+// the first time it's called, and checks that it's unchanged on subsequent calls
+func (KubernetesClusterNodePoolResource) scaleSetUniqueIdUnchanged(uniqueId *string) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+		id, err := parse.NodePoolID(state.ID)
+		if err != nil {
+			return err
+		}
+
+		cluster, err := clients.Containers.KubernetesClustersClient.Get(ctx, id.ResourceGroup, id.ManagedClusterName)
+		if err != nil {
+			return fmt.Errorf("retrieving Kubernetes Cluster %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, err)
+		}
+		if cluster.ManagedClusterProperties == nil || cluster.ManagedClusterProperties.NodeResourceGroup == nil {
+			return fmt.Errorf("retrieving Kubernetes Cluster %q (Resource Group %q): `properties.nodeResourceGroup` was nil", id.ManagedClusterName, id.ResourceGroup)
+		}
+		nodeResourceGroup := *cluster.ManagedClusterProperties.NodeResourceGroup
+
+		scaleSets, err := clients.Compute.VMScaleSetClient.ListComplete(ctx, nodeResourceGroup)
+		if err != nil {
+			return fmt.Errorf("listing Virtual Machine Scale Sets in Resource Group %q: %+v", nodeResourceGroup, err)
+		}
+
+		actual := ""
+		for scaleSets.NotDone() {
+			scaleSet := scaleSets.Value()
+			if v, ok := scaleSet.Tags["aks-managed-poolName"]; ok && v != nil && *v == id.AgentPoolName {
+				if scaleSet.VirtualMachineScaleSetProperties != nil && scaleSet.VirtualMachineScaleSetProperties.UniqueID != nil {
+					actual = *scaleSet.VirtualMachineScaleSetProperties.UniqueID
+				}
+				break
+			}
+
+			if err := scaleSets.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("listing Virtual Machine Scale Sets in Resource Group %q: %+v", nodeResourceGroup, err)
+			}
+		}
+		if actual == "" {
+			return fmt.Errorf("the Virtual Machine Scale Set for Node Pool %q was not found in Resource Group %q", id.AgentPoolName, nodeResourceGroup)
+		}
+
+		if *uniqueId == "" {
+			*uniqueId = actual
+			return nil
+		}
+		if *uniqueId != actual {
+			return fmt.Errorf("expected the Virtual Machine Scale Set for Node Pool %q to be %q but got %q - the Node Pool was recreated", id.AgentPoolName, *uniqueId, actual)
+		}
+
+		return nil
+	}
+}
+
 func (KubernetesClusterNodePoolResource) scaleNodePool(nodeCount int) acceptance.ClientCheckFunc {
 	return func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
 		nodePoolName := state.Attributes["name"]
@@ -1558,7 +1664,7 @@ resource "azurerm_kubernetes_cluster_node_pool" "test" {
 `, r.templateConfig(data), data.RandomInteger)
 }
 
-func (r KubernetesClusterNodePoolResource) nodeTaintsConfig(data acceptance.TestData) string {
+func (r KubernetesClusterNodePoolResource) nodeTaintsConfig(data acceptance.TestData, taint string) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
   features {}
@@ -1572,10 +1678,10 @@ resource "azurerm_kubernetes_cluster_node_pool" "test" {
   vm_size               = "Standard_DS2_v2"
   node_count            = 1
   node_taints = [
-    "key=value:NoSchedule"
+    "%s"
   ]
 }
-`, r.templateConfig(data))
+`, r.templateConfig(data), taint)
 }
 
 func (r KubernetesClusterNodePoolResource) podSubnet(data acceptance.TestData) string {
@@ -2156,3 +2262,53 @@ resource "azurerm_kubernetes_cluster_node_pool" "test" {
 }
 `, r.templateConfig(data), enableAutoScaling, minCount, maxCount)
 }
+
+func (r KubernetesClusterNodePoolResource) snapshotId(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_kubernetes_cluster_node_pool" "source" {
+  name                  = "source"
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+  vm_size               = "Standard_DS2_v2"
+  node_count            = 1
+}
+
+resource "azurerm_kubernetes_node_pool_snapshot" "test" {
+  name                = "acctestsnapshot%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  source_node_pool_id = azurerm_kubernetes_cluster_node_pool.source.id
+}
+
+resource "azurerm_kubernetes_cluster_node_pool" "test" {
+  name                  = "internal"
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+  vm_size               = "Standard_DS2_v2"
+  node_count            = 1
+  snapshot_id           = azurerm_kubernetes_node_pool_snapshot.test.id
+}
+`, r.templateConfig(data), data.RandomInteger)
+}
+
+func (r KubernetesClusterNodePoolResource) scaleDownMode(data acceptance.TestData, scaleDownMode string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_kubernetes_cluster_node_pool" "test" {
+  name                  = "internal"
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+  vm_size               = "Standard_DS2_v2"
+  node_count            = 1
+  scale_down_mode       = %q
+}
+`, r.templateConfig(data), scaleDownMode)
+}
@@ -48,6 +48,23 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("service_principal.0.client_id", func(ctx context.Context, old, new, meta interface{}) bool {
 				return old == "msi" || old == ""
 			}),
+			func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+				if err := validateNodePoolSwapFileSize(diff.Get("default_node_pool.0.kubelet_config").([]interface{}), diff.Get("default_node_pool.0.linux_os_config").([]interface{})); err != nil {
+					return fmt.Errorf("validating `default_node_pool`: %+v", err)
+				}
+				return nil
+			},
+			func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+				// this is only validated for new clusters, so that existing clusters which are already
+				// configured this way can continue to be managed
+				if diff.Id() != "" {
+					return nil
+				}
+
+				// TODO: remove `private_link_enabled` in 3.0
+				privateClusterEnabled := diff.Get("private_cluster_enabled").(bool) || diff.Get("private_link_enabled").(bool)
+				return validateKubernetesClusterPrivateDNSZoneNone(diff.Get("private_dns_zone_id").(string), privateClusterEnabled, diff.Get("private_cluster_public_fqdn_enabled").(bool))
+			},
 		),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
@@ -750,6 +767,18 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				},
 			},
 
+			"run_command_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"running": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"service_principal": {
 				Type:         pluginsdk.TypeList,
 				Optional:     true,
@@ -915,6 +944,45 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				Computed:  true,
 				Sensitive: true,
 			},
+
+			"kube_config_exec": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"host": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"cluster_ca_certificate": {
+							Type:      pluginsdk.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"api_version": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"command": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"args": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"kube_config_exec_raw": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 		},
 	}
 	if features.KubeConfigsAreSensitive() {
@@ -1084,6 +1152,7 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 		EnablePrivateCluster:           &enablePrivateCluster,
 		AuthorizedIPRanges:             apiServerAuthorizedIPRanges,
 		EnablePrivateClusterPublicFQDN: utils.Bool(d.Get("private_cluster_public_fqdn_enabled").(bool)),
+		DisableRunCommand:              utils.Bool(!d.Get("run_command_enabled").(bool)),
 	}
 
 	nodeResourceGroup := d.Get("node_resource_group").(string)
@@ -1185,6 +1254,9 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("waiting for creation of Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
+	id := parse.NewClusterID(client.SubscriptionID, resGroup, name)
+	d.SetId(id.ID())
+
 	if maintenanceConfigRaw, ok := d.GetOk("maintenance_window"); ok {
 		client := meta.(*clients.Client).Containers.MaintenanceConfigurationsClient
 		parameters := containerservice.MaintenanceConfiguration{
@@ -1195,8 +1267,11 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
-	id := parse.NewClusterID(client.SubscriptionID, resGroup, name)
-	d.SetId(id.ID())
+	if !d.Get("running").(bool) {
+		if err := stopKubernetesCluster(ctx, client, id); err != nil {
+			return err
+		}
+	}
 
 	return resourceKubernetesClusterRead(d, meta)
 }
@@ -1232,6 +1307,13 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		return err
 	}
 
+	// a stopped cluster can't be updated, so it needs to be started prior to any other changes being applied
+	if d.HasChange("running") && d.Get("running").(bool) {
+		if err := startKubernetesCluster(ctx, clusterClient, *id); err != nil {
+			return err
+		}
+	}
+
 	// when update, we should set the value of `Identity.UserAssignedIdentities` empty
 	// otherwise the rest api will report error - this is tracked here: https://github.com/Azure/azure-rest-api-specs/issues/13631
 	if existing.Identity != nil && existing.Identity.UserAssignedIdentities != nil {
@@ -1341,6 +1423,7 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		existing.ManagedClusterProperties.APIServerAccessProfile = &containerservice.ManagedClusterAPIServerAccessProfile{
 			AuthorizedIPRanges:   utils.ExpandStringSlice(apiServerAuthorizedIPRangesRaw),
 			EnablePrivateCluster: &enablePrivateCluster,
+			DisableRunCommand:    utils.Bool(!d.Get("run_command_enabled").(bool)),
 		}
 		if v, ok := d.GetOk("private_dns_zone_id"); ok {
 			existing.ManagedClusterProperties.APIServerAccessProfile.PrivateDNSZone = utils.String(v.(string))
@@ -1352,6 +1435,14 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		existing.ManagedClusterProperties.APIServerAccessProfile.EnablePrivateClusterPublicFQDN = utils.Bool(d.Get("private_cluster_public_fqdn_enabled").(bool))
 	}
 
+	if d.HasChange("run_command_enabled") {
+		updateCluster = true
+		if existing.ManagedClusterProperties.APIServerAccessProfile == nil {
+			existing.ManagedClusterProperties.APIServerAccessProfile = &containerservice.ManagedClusterAPIServerAccessProfile{}
+		}
+		existing.ManagedClusterProperties.APIServerAccessProfile.DisableRunCommand = utils.Bool(!d.Get("run_command_enabled").(bool))
+	}
+
 	if d.HasChange("auto_scaler_profile") {
 		updateCluster = true
 		autoScalerProfileRaw := d.Get("auto_scaler_profile").([]interface{})
@@ -1578,6 +1669,13 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
+	// conversely the cluster is only stopped once all other changes have been applied
+	if d.HasChange("running") && !d.Get("running").(bool) {
+		if err := stopKubernetesCluster(ctx, clusterClient, *id); err != nil {
+			return err
+		}
+	}
+
 	d.Partial(false)
 
 	return resourceKubernetesClusterRead(d, meta)
@@ -1633,6 +1731,12 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 		d.Set("enable_pod_security_policy", props.EnablePodSecurityPolicy)
 		d.Set("local_account_disabled", props.DisableLocalAccounts)
 
+		running := true
+		if props.PowerState != nil && props.PowerState.Code == containerservice.CodeStopped {
+			running = false
+		}
+		d.Set("running", running)
+
 		upgradeChannel := ""
 		if profile := props.AutoUpgradeProfile; profile != nil && profile.UpgradeChannel != containerservice.UpgradeChannelNone {
 			upgradeChannel = string(profile.UpgradeChannel)
@@ -1649,6 +1753,7 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 			d.Set("private_link_enabled", accessProfile.EnablePrivateCluster)
 			d.Set("private_cluster_enabled", accessProfile.EnablePrivateCluster)
 			d.Set("private_cluster_public_fqdn_enabled", accessProfile.EnablePrivateClusterPublicFQDN)
+			d.Set("run_command_enabled", accessProfile.DisableRunCommand == nil || !*accessProfile.DisableRunCommand)
 			switch {
 			case accessProfile.PrivateDNSZone != nil && strings.EqualFold("System", *accessProfile.PrivateDNSZone):
 				d.Set("private_dns_zone_id", "System")
@@ -1746,6 +1851,12 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("setting `kube_config`: %+v", err)
 	}
 
+	kubeConfigExecRaw, kubeConfigExec := flattenKubernetesClusterAccessProfileExec(profile)
+	d.Set("kube_config_exec_raw", kubeConfigExecRaw)
+	if err := d.Set("kube_config_exec", kubeConfigExec); err != nil {
+		return fmt.Errorf("setting `kube_config_exec`: %+v", err)
+	}
+
 	maintenanceConfigurationsClient := meta.(*clients.Client).Containers.MaintenanceConfigurationsClient
 	configResp, _ := maintenanceConfigurationsClient.Get(ctx, id.ResourceGroup, id.ManagedClusterName, "default")
 	if props := configResp.MaintenanceConfigurationProperties; props != nil {
@@ -1784,6 +1895,36 @@ func resourceKubernetesClusterDelete(d *pluginsdk.ResourceData, meta interface{}
 	return nil
 }
 
+func startKubernetesCluster(ctx context.Context, client *containerservice.ManagedClustersClient, id parse.ClusterId) error {
+	log.Printf("[DEBUG] Starting %s..", id)
+	future, err := client.Start(ctx, id.ResourceGroup, id.ManagedClusterName)
+	if err != nil {
+		return fmt.Errorf("starting %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for %s to start: %+v", id, err)
+	}
+	log.Printf("[DEBUG] Started %s.", id)
+
+	return nil
+}
+
+func stopKubernetesCluster(ctx context.Context, client *containerservice.ManagedClustersClient, id parse.ClusterId) error {
+	log.Printf("[DEBUG] Stopping %s..", id)
+	future, err := client.Stop(ctx, id.ResourceGroup, id.ManagedClusterName)
+	if err != nil {
+		return fmt.Errorf("stopping %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for %s to stop: %+v", id, err)
+	}
+	log.Printf("[DEBUG] Stopped %s.", id)
+
+	return nil
+}
+
 func flattenKubernetesClusterAccessProfile(profile containerservice.ManagedClusterAccessProfile) (*string, []interface{}) {
 	if accessProfile := profile.AccessProfile; accessProfile != nil {
 		if kubeConfigRaw := accessProfile.KubeConfig; kubeConfigRaw != nil {
@@ -1812,6 +1953,64 @@ func flattenKubernetesClusterAccessProfile(profile containerservice.ManagedClust
 	return nil, []interface{}{}
 }
 
+// validateKubernetesClusterPrivateDNSZoneNone validates the configuration of a Private Cluster which doesn't use a
+// Private DNS Zone - since AKS won't create a Private DNS Zone for the API Server, it can only be resolved (both by
+// the nodes and by clients) through its public FQDN, which resolves to the private IP address of the API Server
+func validateKubernetesClusterPrivateDNSZoneNone(privateDNSZoneId string, privateClusterEnabled bool, publicFQDNEnabled bool) error {
+	if !strings.EqualFold(privateDNSZoneId, "None") {
+		return nil
+	}
+
+	if !privateClusterEnabled {
+		return fmt.Errorf("`private_dns_zone_id` can only be set to `None` when `private_cluster_enabled` is set to `true`")
+	}
+
+	if !publicFQDNEnabled {
+		return fmt.Errorf("`private_cluster_public_fqdn_enabled` must be set to `true` when `private_dns_zone_id` is set to `None` - since no Private DNS Zone is created the API Server can only be resolved through its public FQDN")
+	}
+
+	return nil
+}
+
+// flattenKubernetesClusterAccessProfileExec converts the kubeconfig for an Azure Active Directory enabled cluster into
+// one using the `kubelogin` exec credential plugin, since the `azure` auth-provider has been removed from kubectl
+func flattenKubernetesClusterAccessProfileExec(profile containerservice.ManagedClusterAccessProfile) (*string, []interface{}) {
+	accessProfile := profile.AccessProfile
+	if accessProfile == nil || accessProfile.KubeConfig == nil {
+		return nil, []interface{}{}
+	}
+
+	rawConfig := string(*accessProfile.KubeConfig)
+	if !strings.Contains(rawConfig, "apiserver-id:") {
+		return nil, []interface{}{}
+	}
+
+	kubeConfigAAD, err := kubernetes.ParseKubeConfigAAD(rawConfig)
+	if err != nil {
+		return nil, []interface{}{}
+	}
+
+	kubeConfigExec := kubernetes.ConvertKubeConfigAADToExec(*kubeConfigAAD)
+	kubeConfigExecRaw, err := kubernetes.MarshalKubeConfigExec(kubeConfigExec)
+	if err != nil {
+		return nil, []interface{}{}
+	}
+
+	// we don't size-check these since they're validated in the Parse method
+	cluster := kubeConfigExec.Clusters[0].Cluster
+	exec := kubeConfigExec.Users[0].User.Exec
+
+	return utils.String(kubeConfigExecRaw), []interface{}{
+		map[string]interface{}{
+			"host":                   cluster.Server,
+			"cluster_ca_certificate": cluster.ClusterAuthorityData,
+			"api_version":            exec.APIVersion,
+			"command":                exec.Command,
+			"args":                   exec.Args,
+		},
+	}
+}
+
 func expandKubernetesClusterLinuxProfile(input []interface{}) *containerservice.LinuxProfile {
 	if len(input) == 0 {
 		return nil
@@ -126,7 +126,6 @@ func SchemaDefaultNodePool() *pluginsdk.Schema {
 
 				"node_labels": {
 					Type:     pluginsdk.TypeMap,
-					ForceNew: true,
 					Optional: true,
 					Computed: true,
 					Elem: &pluginsdk.Schema{
@@ -144,7 +143,6 @@ func SchemaDefaultNodePool() *pluginsdk.Schema {
 
 				"node_taints": {
 					Type:     pluginsdk.TypeList,
-					ForceNew: true,
 					Optional: true,
 					Elem: &pluginsdk.Schema{
 						Type: pluginsdk.TypeString,
@@ -183,6 +181,16 @@ func SchemaDefaultNodePool() *pluginsdk.Schema {
 					}, false),
 				},
 
+				"scale_down_mode": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Default:  string(containerservice.ScaleDownModeDelete),
+					ValidateFunc: validation.StringInSlice([]string{
+						string(containerservice.ScaleDownModeDeallocate),
+						string(containerservice.ScaleDownModeDelete),
+					}, false),
+				},
+
 				"ultra_ssd_enabled": {
 					Type:     pluginsdk.TypeBool,
 					ForceNew: true,
@@ -599,6 +607,7 @@ func ConvertDefaultNodePoolToAgentPool(input *[]containerservice.ManagedClusterA
 			NodePublicIPPrefixID:      defaultCluster.NodePublicIPPrefixID,
 			ScaleSetPriority:          defaultCluster.ScaleSetPriority,
 			ScaleSetEvictionPolicy:    defaultCluster.ScaleSetEvictionPolicy,
+			ScaleDownMode:             defaultCluster.ScaleDownMode,
 			SpotMaxPrice:              defaultCluster.SpotMaxPrice,
 			Mode:                      defaultCluster.Mode,
 			NodeLabels:                defaultCluster.NodeLabels,
@@ -640,6 +649,7 @@ func ExpandDefaultNodePool(d *pluginsdk.ResourceData) (*[]containerservice.Manag
 		Name:                   utils.String(raw["name"].(string)),
 		NodeLabels:             nodeLabels,
 		NodeTaints:             nodeTaints,
+		ScaleDownMode:          containerservice.ScaleDownMode(raw["scale_down_mode"].(string)),
 		Tags:                   tags.Expand(t),
 		Type:                   containerservice.AgentPoolType(raw["type"].(string)),
 		VMSize:                 utils.String(raw["vm_size"].(string)),
@@ -840,6 +850,26 @@ func expandAgentPoolLinuxOSConfig(input []interface{}) (*containerservice.LinuxO
 	return result, nil
 }
 
+// validateNodePoolSwapFileSize ensures that a `kubelet_config` block is configured when a swap file is enabled
+// within the `linux_os_config` block, since the kubelet must be configured to run with swap enabled (which is
+// done by setting `FailSwapOn` to `false` when expanding the `kubelet_config` block)
+func validateNodePoolSwapFileSize(kubeletConfig []interface{}, linuxOSConfig []interface{}) error {
+	if len(linuxOSConfig) == 0 || linuxOSConfig[0] == nil {
+		return nil
+	}
+
+	raw := linuxOSConfig[0].(map[string]interface{})
+	if raw["swap_file_size_mb"].(int) == 0 {
+		return nil
+	}
+
+	if len(kubeletConfig) == 0 || kubeletConfig[0] == nil {
+		return fmt.Errorf("a `kubelet_config` block must be configured when `linux_os_config.0.swap_file_size_mb` is set, since the kubelet must be configured to run with swap enabled")
+	}
+
+	return nil
+}
+
 func expandAgentPoolSysctlConfig(input []interface{}) (*containerservice.SysctlConfig, error) {
 	if len(input) == 0 || input[0] == nil {
 		return nil, nil
@@ -1062,6 +1092,11 @@ func FlattenDefaultNodePool(input *[]containerservice.ManagedClusterAgentPoolPro
 		vmSize = *agentPool.VMSize
 	}
 
+	scaleDownMode := string(containerservice.ScaleDownModeDelete)
+	if agentPool.ScaleDownMode != "" {
+		scaleDownMode = string(agentPool.ScaleDownMode)
+	}
+
 	upgradeSettings := flattenUpgradeSettings(agentPool.UpgradeSettings)
 	linuxOSConfig, err := flattenAgentPoolLinuxOSConfig(agentPool.LinuxOSConfig)
 	if err != nil {
@@ -1086,6 +1121,7 @@ func FlattenDefaultNodePool(input *[]containerservice.ManagedClusterAgentPoolPro
 			"os_disk_size_gb":              osDiskSizeGB,
 			"os_disk_type":                 string(osDiskType),
 			"os_sku":                       string(agentPool.OsSKU),
+			"scale_down_mode":              scaleDownMode,
 			"tags":                         tags.Flatten(agentPool.Tags),
 			"type":                         string(agentPool.Type),
 			"ultra_ssd_enabled":            enableUltraSSD,
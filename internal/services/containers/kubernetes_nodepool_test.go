@@ -0,0 +1,83 @@
+package containers
+
+import (
+	"testing"
+)
+
+func TestValidateNodePoolSwapFileSize(t *testing.T) {
+	testData := []struct {
+		name          string
+		kubeletConfig []interface{}
+		linuxOSConfig []interface{}
+		expectError   bool
+	}{
+		{
+			name:          "neither block",
+			kubeletConfig: []interface{}{},
+			linuxOSConfig: []interface{}{},
+			expectError:   false,
+		},
+		{
+			name:          "empty linux os config block",
+			kubeletConfig: []interface{}{},
+			linuxOSConfig: []interface{}{nil},
+			expectError:   false,
+		},
+		{
+			name:          "linux os config without a swap file",
+			kubeletConfig: []interface{}{},
+			linuxOSConfig: []interface{}{
+				map[string]interface{}{
+					"swap_file_size_mb": 0,
+				},
+			},
+			expectError: false,
+		},
+		{
+			name:          "swap file without a kubelet config block",
+			kubeletConfig: []interface{}{},
+			linuxOSConfig: []interface{}{
+				map[string]interface{}{
+					"swap_file_size_mb": 300,
+				},
+			},
+			expectError: true,
+		},
+		{
+			name:          "swap file with an empty kubelet config block",
+			kubeletConfig: []interface{}{nil},
+			linuxOSConfig: []interface{}{
+				map[string]interface{}{
+					"swap_file_size_mb": 300,
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "swap file with a kubelet config block",
+			kubeletConfig: []interface{}{
+				map[string]interface{}{
+					"cpu_manager_policy": "static",
+				},
+			},
+			linuxOSConfig: []interface{}{
+				map[string]interface{}{
+					"swap_file_size_mb": 300,
+				},
+			},
+			expectError: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		err := validateNodePoolSwapFileSize(v.kubeletConfig, v.linuxOSConfig)
+		if v.expectError && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !v.expectError && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package containers_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers"
+)
+
+// TestKubernetesNodePoolLabelsAndTaintsUpdateInPlace confirms that changing the Node Labels or Node Taints
+// for either the Default Node Pool or an additional Node Pool doesn't plan the recreation of the resource
+func TestKubernetesNodePoolLabelsAndTaintsUpdateInPlace(t *testing.T) {
+	testData := []struct {
+		resourceType string
+		prefix       string
+		state        map[string]string
+		config       map[string]interface{}
+	}{
+		{
+			resourceType: "azurerm_kubernetes_cluster",
+			prefix:       "default_node_pool.0.",
+			state: map[string]string{
+				"name":                                "example",
+				"location":                            "westeurope",
+				"resource_group_name":                 "example",
+				"dns_prefix":                          "example",
+				"default_node_pool.#":                 "1",
+				"default_node_pool.0.name":            "default",
+				"default_node_pool.0.vm_size":         "Standard_DS2_v2",
+				"default_node_pool.0.node_labels.%":   "1",
+				"default_node_pool.0.node_labels.key": "value",
+			},
+			config: map[string]interface{}{
+				"name":                "example",
+				"location":            "westeurope",
+				"resource_group_name": "example",
+				"dns_prefix":          "example",
+				"default_node_pool": []interface{}{
+					map[string]interface{}{
+						"name":    "default",
+						"vm_size": "Standard_DS2_v2",
+						"node_labels": map[string]interface{}{
+							"key2": "value2",
+						},
+					},
+				},
+			},
+		},
+		{
+			resourceType: "azurerm_kubernetes_cluster_node_pool",
+			state: map[string]string{
+				"name":                  "internal",
+				"kubernetes_cluster_id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ContainerService/managedClusters/cluster1",
+				"vm_size":               "Standard_DS2_v2",
+				"node_labels.%":         "1",
+				"node_labels.key":       "value",
+				"node_taints.#":         "1",
+				"node_taints.0":         "key=value:NoSchedule",
+			},
+			config: map[string]interface{}{
+				"name":                  "internal",
+				"kubernetes_cluster_id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ContainerService/managedClusters/cluster1",
+				"vm_size":               "Standard_DS2_v2",
+				"node_labels": map[string]interface{}{
+					"key2": "value2",
+				},
+				"node_taints": []interface{}{
+					"key2=value2:NoExecute",
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.resourceType)
+
+		resource, ok := containers.Registration{}.SupportedResources()[v.resourceType]
+		if !ok {
+			t.Fatalf("resource %q was not registered", v.resourceType)
+		}
+
+		state := &terraform.InstanceState{
+			ID:         "example",
+			Attributes: v.state,
+		}
+		diff, err := resource.SimpleDiff(context.TODO(), state, terraform.NewResourceConfigRaw(v.config), nil)
+		if err != nil {
+			t.Fatalf("building diff for %q: %+v", v.resourceType, err)
+		}
+		if diff == nil {
+			t.Fatalf("expected a diff for %q but didn't get one", v.resourceType)
+		}
+
+		changed := false
+		for key, attr := range diff.Attributes {
+			if !strings.HasPrefix(key, v.prefix+"node_labels") && !strings.HasPrefix(key, v.prefix+"node_taints") {
+				continue
+			}
+
+			changed = true
+			if attr.RequiresNew {
+				t.Fatalf("expected %q to be updated in-place for %q but it requires a new resource", key, v.resourceType)
+			}
+		}
+		if !changed {
+			t.Fatalf("expected a change to the Node Labels/Taints for %q but didn't get one", v.resourceType)
+		}
+	}
+}
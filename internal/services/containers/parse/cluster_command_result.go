@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+)
+
+type ClusterCommandResultId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	ManagedClusterName string
+	CommandResultName  string
+}
+
+func NewClusterCommandResultID(subscriptionId, resourceGroup, managedClusterName, commandResultName string) ClusterCommandResultId {
+	return ClusterCommandResultId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		ManagedClusterName: managedClusterName,
+		CommandResultName:  commandResultName,
+	}
+}
+
+func (id ClusterCommandResultId) String() string {
+	segments := []string{
+		fmt.Sprintf("Command Result Name %q", id.CommandResultName),
+		fmt.Sprintf("Managed Cluster Name %q", id.ManagedClusterName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Cluster Command Result", segmentsStr)
+}
+
+func (id ClusterCommandResultId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerService/managedClusters/%s/commandResults/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ManagedClusterName, id.CommandResultName)
+}
+
+// ClusterCommandResultID parses a ClusterCommandResult ID into an ClusterCommandResultId struct
+func ClusterCommandResultID(input string) (*ClusterCommandResultId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ClusterCommandResultId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ManagedClusterName, err = id.PopSegment("managedClusters"); err != nil {
+		return nil, err
+	}
+	if resourceId.CommandResultName, err = id.PopSegment("commandResults"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
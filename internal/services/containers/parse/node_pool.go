@@ -73,3 +73,59 @@ func NodePoolID(input string) (*NodePoolId, error) {
 
 	return &resourceId, nil
 }
+
+// NodePoolIDInsensitively parses an NodePool ID into an NodePoolId struct, insensitively
+// This should only be used to parse an ID for rewriting, the NodePoolID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func NodePoolIDInsensitively(input string) (*NodePoolId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := NodePoolId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'managedClusters' segment
+	managedClustersKey := "managedClusters"
+	for key := range id.Path {
+		if strings.EqualFold(key, managedClustersKey) {
+			managedClustersKey = key
+			break
+		}
+	}
+	if resourceId.ManagedClusterName, err = id.PopSegment(managedClustersKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'agentPools' segment
+	agentPoolsKey := "agentPools"
+	for key := range id.Path {
+		if strings.EqualFold(key, agentPoolsKey) {
+			agentPoolsKey = key
+			break
+		}
+	}
+	if resourceId.AgentPoolName, err = id.PopSegment(agentPoolsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
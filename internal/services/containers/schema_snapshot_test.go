@@ -0,0 +1,133 @@
+package containers_test
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// the snapshot can be regenerated following an intentional schema change by running
+// `go test ./internal/services/containers -run TestSchemaSnapshot -update-schema-snapshot`
+var updateSchemaSnapshot = flag.Bool("update-schema-snapshot", false, "regenerate the schema snapshot for the containers service")
+
+var schemaSnapshotPath = filepath.Join("testdata", "schema_snapshot.txt")
+
+// TestSchemaSnapshot compares the schemas for the Data Sources and Resources within this service against a snapshot,
+// to catch accidental changes to the Type, ForceNew, Computed or Sensitive behaviour of a field - which can force
+// users to recreate resources or cause values to be exposed in the plan
+func TestSchemaSnapshot(t *testing.T) {
+	if features.KubeConfigsAreSensitive() {
+		t.Skip("Skipping since `ARM_AKS_KUBE_CONFIGS_SENSITIVE` changes the schema for the Kubernetes Cluster")
+	}
+
+	actual := buildSchemaSnapshot(containers.Registration{})
+
+	if *updateSchemaSnapshot {
+		if err := ioutil.WriteFile(schemaSnapshotPath, []byte(strings.Join(actual, "\n")+"\n"), 0644); err != nil {
+			t.Fatalf("writing schema snapshot: %+v", err)
+		}
+		return
+	}
+
+	raw, err := ioutil.ReadFile(schemaSnapshotPath)
+	if err != nil {
+		t.Fatalf("reading schema snapshot: %+v", err)
+	}
+	expected := strings.Split(strings.TrimSpace(string(raw)), "\n")
+
+	if differences := diffSchemaSnapshot(expected, actual); len(differences) > 0 {
+		t.Fatalf("the schema differs from the snapshot in %q:\n\n%s\n\nif this change is intentional the snapshot can be updated by running the tests with `-update-schema-snapshot`", schemaSnapshotPath, strings.Join(differences, "\n"))
+	}
+}
+
+func buildSchemaSnapshot(registration containers.Registration) []string {
+	lines := make([]string, 0)
+	for name, resource := range registration.SupportedDataSources() {
+		lines = append(lines, flattenSchemaSnapshot(fmt.Sprintf("data.%s", name), resource.Schema)...)
+	}
+	for name, resource := range registration.SupportedResources() {
+		lines = append(lines, flattenSchemaSnapshot(name, resource.Schema)...)
+	}
+	sort.Strings(lines)
+	return lines
+}
+
+func flattenSchemaSnapshot(prefix string, input map[string]*pluginsdk.Schema) []string {
+	output := make([]string, 0)
+	for name, field := range input {
+		path := fmt.Sprintf("%s.%s", prefix, name)
+
+		fieldType := field.Type.String()
+		switch elem := field.Elem.(type) {
+		case *pluginsdk.Schema:
+			fieldType = fmt.Sprintf("%s(%s)", fieldType, elem.Type.String())
+		case *pluginsdk.Resource:
+			output = append(output, flattenSchemaSnapshot(path, elem.Schema)...)
+		}
+
+		behaviours := []string{fieldType}
+		for behaviour, enabled := range map[string]bool{
+			"Required":  field.Required,
+			"Optional":  field.Optional,
+			"Computed":  field.Computed,
+			"ForceNew":  field.ForceNew,
+			"Sensitive": field.Sensitive,
+		} {
+			if enabled {
+				behaviours = append(behaviours, behaviour)
+			}
+		}
+		sort.Strings(behaviours[1:])
+
+		output = append(output, fmt.Sprintf("%s: %s", path, strings.Join(behaviours, " ")))
+	}
+	return output
+}
+
+func diffSchemaSnapshot(expected, actual []string) []string {
+	expectedFields := make(map[string]string)
+	for _, line := range expected {
+		path, behaviours := splitSchemaSnapshotLine(line)
+		expectedFields[path] = behaviours
+	}
+	actualFields := make(map[string]string)
+	for _, line := range actual {
+		path, behaviours := splitSchemaSnapshotLine(line)
+		actualFields[path] = behaviours
+	}
+
+	differences := make([]string, 0)
+	for path, behaviours := range expectedFields {
+		other, ok := actualFields[path]
+		if !ok {
+			differences = append(differences, fmt.Sprintf("- %s: removed (was %q)", path, behaviours))
+			continue
+		}
+		if other != behaviours {
+			differences = append(differences, fmt.Sprintf("~ %s: changed from %q to %q", path, behaviours, other))
+		}
+	}
+	for path, behaviours := range actualFields {
+		if _, ok := expectedFields[path]; !ok {
+			differences = append(differences, fmt.Sprintf("+ %s: added (%q)", path, behaviours))
+		}
+	}
+	sort.Strings(differences)
+	return differences
+}
+
+func splitSchemaSnapshotLine(line string) (string, string) {
+	segments := strings.SplitN(line, ": ", 2)
+	if len(segments) != 2 {
+		return line, ""
+	}
+	return segments[0], segments[1]
+}